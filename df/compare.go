@@ -0,0 +1,189 @@
+package df
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// Comparison is the result of Compare.
+type Comparison struct {
+	// Added holds the rows of the new Dataframe whose key is not in the old one.
+	Added *Dataframe
+	// Removed holds the rows of the old Dataframe whose key is not in the new one.
+	Removed *Dataframe
+	// Modified holds one row per changed cell: the key columns, then "column" (the header
+	// of the changed column), "old" and "new" (the values formatted as strings, null for nulls).
+	Modified *Dataframe
+	// Summary holds one row per compared column: "column" and "modified" (number of changed cells).
+	Summary *Dataframe
+}
+
+// Compare matches the rows of an older and a newer Dataframe on the key columns and
+// reports the rows added and removed in newer, and the cells whose value changed. The compared columns
+// are the non-key columns present in both Dataframes; numbers and floats compare by value
+// so that a column inferred with another type tag does not report spurious changes.
+// It returns an error if a key column is missing or a key is duplicated.
+// Examples:
+//
+//	cmp, err := df.Compare(yesterday, today, "id")
+//	cmp.Modified.Debug()
+//	// | id(number) | column(string) | old(string) | new(string) |
+//	// | 42         | price          | 9.9         | 10.5        |
+func Compare(older *Dataframe, newer *Dataframe, keyColumns ...string) (*Comparison, error) {
+	if len(keyColumns) == 0 {
+		return nil, fmt.Errorf("compare: no key column")
+	}
+	types, err := keyTypes(older, newer, keyColumns)
+	if err != nil {
+		return nil, err
+	}
+	oldKeys, err := rowKeys(older, keyColumns, types, "old")
+	if err != nil {
+		return nil, err
+	}
+	newKeys, err := rowKeys(newer, keyColumns, types, "new")
+	if err != nil {
+		return nil, err
+	}
+	oldRows := make(map[string]int, len(oldKeys))
+	for r, key := range oldKeys {
+		oldRows[key] = r
+	}
+	newRows := make(map[string]int, len(newKeys))
+	for r, key := range newKeys {
+		newRows[key] = r
+	}
+
+	var columns []string
+	var befores, afters []series.Series[any]
+	for j, header := range newer.headers {
+		if i := fnVisual.IndexOf(header, older.headers); i >= 0 && !is.In(header, keyColumns) {
+			columns = append(columns, header)
+			befores = append(befores, older.sheet[i])
+			afters = append(afters, newer.sheet[j])
+		}
+	}
+	keys := fnVisual.Map(keyColumns, func(keyColumn string, _ int) series.Series[any] {
+		s, _ := newer.GetSeriesByHeader(keyColumn)
+		return s
+	})
+	keyValues := make([][]any, len(keyColumns))
+	var changedColumns, oldValues, newValues []any
+	counts := make([]int, len(columns))
+	added := make([]bool, len(newKeys))
+	for r, key := range newKeys {
+		o, ok := oldRows[key]
+		if !ok {
+			added[r] = true
+			continue
+		}
+		for c, header := range columns {
+			before, after := befores[c].GetValue(o), afters[c].GetValue(r)
+			if cellEqual(before, after, EqualOptions{IgnoreTypes: true}) {
+				continue
+			}
+			counts[c]++
+			for k, s := range keys {
+				keyValues[k] = append(keyValues[k], s.GetValue(r))
+			}
+			changedColumns = append(changedColumns, header)
+			oldValues = append(oldValues, cellString(before))
+			newValues = append(newValues, cellString(after))
+		}
+	}
+	removed := fnVisual.Map(oldKeys, func(key string, _ int) bool {
+		_, ok := newRows[key]
+		return !ok
+	})
+
+	modified := New(nil, nil)
+	for k, s := range keys {
+		modified.Append(series.NewNullable(keyValues[k], s.Type()), keyColumns[k])
+	}
+	modified.Append(series.New(changedColumns, "string"), "column")
+	modified.Append(series.NewNullable(oldValues, "string"), "old")
+	modified.Append(series.NewNullable(newValues, "string"), "new")
+
+	return &Comparison{
+		Added:    selectRows(newer, added),
+		Removed:  selectRows(older, removed),
+		Modified: modified,
+		Summary: New([]series.Series[any]{
+			series.New(fnVisual.Map(columns, func(h string, _ int) any { return h }), "string"),
+			series.New(fnVisual.Map(counts, func(c int, _ int) any { return c }), "number"),
+		}, []string{"column", "modified"}),
+	}, nil
+}
+
+// keyTypes returns the type tag each key column is compared as: its type when it is the
+// same in both Dataframes, "float" for numbers and floats, "date" for dates and strings
+// and "string" otherwise. It returns an error if a key column is missing.
+func keyTypes(older *Dataframe, newer *Dataframe, keyColumns []string) ([]string, error) {
+	types := make([]string, len(keyColumns))
+	for k, keyColumn := range keyColumns {
+		i := fnVisual.IndexOf(keyColumn, older.headers)
+		if i < 0 {
+			return nil, fmt.Errorf("compare: key column %q not found in the old Dataframe", keyColumn)
+		}
+		j := fnVisual.IndexOf(keyColumn, newer.headers)
+		if j < 0 {
+			return nil, fmt.Errorf("compare: key column %q not found in the new Dataframe", keyColumn)
+		}
+		t, ok := series.CompareType(older.sheet[i].Type(), newer.sheet[j].Type())
+		if !ok {
+			t = "string"
+		}
+		types[k] = t
+	}
+	return types, nil
+}
+
+// rowKeys returns a key per row built from the key columns, whose cells are first
+// converted to the key types, and an error if a key appears twice. name identifies df in
+// error messages.
+func rowKeys(df *Dataframe, keyColumns []string, types []string, name string) ([]string, error) {
+	keys := make([]string, df.rows())
+	for k, keyColumn := range keyColumns {
+		col := df.sheet[fnVisual.IndexOf(keyColumn, df.headers)]
+		for r := range keys {
+			v := col.GetValue(r)
+			if converted, err := coerceValue(v, types[k]); err == nil {
+				v = converted
+			}
+			keys[r] += formatCell(v) + "\x00"
+		}
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			return nil, fmt.Errorf("compare: duplicate key (%s) in the %s Dataframe", strings.ReplaceAll(strings.TrimSuffix(key, "\x00"), "\x00", ", "), name)
+		}
+		seen[key] = true
+	}
+	return keys, nil
+}
+
+// selectRows returns a new Dataframe with the rows of df where keep is true.
+func selectRows(df *Dataframe, keep []bool) *Dataframe {
+	out := df.Copy()
+	out.keepRows(keep)
+	return out
+}
+
+// cellString formats a cell as a string value, keeping nulls as nil.
+func cellString(v any) any {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return value
+	case time.Time:
+		return value.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,131 @@
+package df
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestDf_Compare(t *testing.T) {
+	older := makeDF(
+		[][]any{{1, 2, 3}, {"Ana", "Bob", "Cyd"}, {9.9, 5.0, 1.0}},
+		[]string{"number", "string", "float"},
+		[]string{"id", "name", "price"},
+	)
+	newer := New([]series.Series[any]{
+		series.New([]any{3, 2, 4}, "number"),
+		series.New([]any{1.0, 5.5, 7.0}, "float"),
+		series.NewNullable([]any{"Cyd", nil, "Dan"}, "string"),
+		series.New([]any{true, false, true}, "bool"),
+	}, []string{"id", "price", "name", "active"})
+
+	cmp, err := Compare(older, newer, "id")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	testCases := []struct {
+		name     string
+		got      *Dataframe
+		expected *Dataframe
+	}{
+		{
+			name:     "added",
+			got:      cmp.Added,
+			expected: New([]series.Series[any]{series.New([]any{4}, "number"), series.New([]any{7.0}, "float"), series.New([]any{"Dan"}, "string"), series.New([]any{true}, "bool")}, []string{"id", "price", "name", "active"}),
+		},
+		{
+			name:     "removed",
+			got:      cmp.Removed,
+			expected: makeDF([][]any{{1}, {"Ana"}, {9.9}}, []string{"number", "string", "float"}, []string{"id", "name", "price"}),
+		},
+		{
+			name: "modified",
+			got:  cmp.Modified,
+			expected: New([]series.Series[any]{
+				series.New([]any{2, 2}, "number"),
+				series.New([]any{"price", "name"}, "string"),
+				series.New([]any{"5", "Bob"}, "string"),
+				series.NewNullable([]any{"5.5", nil}, "string"),
+			}, []string{"id", "column", "old", "new"}),
+		},
+		{
+			name:     "summary",
+			got:      cmp.Summary,
+			expected: makeDF([][]any{{"price", "name"}, {1, 1}}, []string{"string", "number"}, []string{"column", "modified"}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if report := Diff(tc.got, tc.expected, EqualOptions{}); report != "" {
+				t.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestDf_CompareKeyTypes(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	older := New([]series.Series[any]{
+		series.New([]any{1, 2}, "number"),
+		series.New([]any{day, day}, "date"),
+		series.New([]any{day, day}, "date"),
+	}, []string{"id", "day", "since"})
+	newer := New([]series.Series[any]{
+		series.New([]any{"2", "1"}, "string"),
+		series.New([]any{"2024-03-01", "2024-03-01T00:00:00Z"}, "string"),
+		series.New([]any{"2024-03-01", "2024-03-02"}, "string"),
+	}, []string{"id", "day", "since"})
+
+	cmp, err := Compare(older, newer, "id", "day")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if cmp.Added.rows() != 0 || cmp.Removed.rows() != 0 {
+		t.Fatalf("expected the keys to match, got %d added and %d removed rows", cmp.Added.rows(), cmp.Removed.rows())
+	}
+	expected := New([]series.Series[any]{
+		series.New([]any{"1"}, "string"),
+		series.New([]any{"2024-03-01T00:00:00Z"}, "string"),
+		series.New([]any{"since"}, "string"),
+		series.New([]any{"2024-03-01T00:00:00Z"}, "string"),
+		series.New([]any{"2024-03-02"}, "string"),
+	}, []string{"id", "day", "column", "old", "new"})
+	if report := Diff(cmp.Modified, expected, EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+}
+
+func TestDf_CompareErrors(t *testing.T) {
+	frame := makeDF([][]any{{1, 1}, {"a", "b"}}, []string{"number", "string"}, []string{"id", "name"})
+	unique := makeDF([][]any{{1, 2}, {"a", "b"}}, []string{"number", "string"}, []string{"id", "name"})
+	testCases := []struct {
+		name     string
+		older    *Dataframe
+		newer    *Dataframe
+		keys     []string
+		expected string
+	}{
+		{"no key", unique, unique, nil, "no key column"},
+		{"missing key", unique, unique, []string{"code"}, `key column "code" not found in the old Dataframe`},
+		{"duplicate key", unique, frame, []string{"id"}, "duplicate key (1) in the new Dataframe"},
+		{"composite key", frame, frame, []string{"id", "name"}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compare(tc.older, tc.newer, tc.keys...)
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected an error containing %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
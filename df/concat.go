@@ -0,0 +1,158 @@
+package df
+
+import (
+	"fmt"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// Axis values accepted by Concat.
+const (
+	AxisRows    = 0 // stack frames vertically, aligning columns by header
+	AxisColumns = 1 // place frames side by side
+)
+
+// Join values accepted by ConcatOptions.
+const (
+	JoinOuter = "outer" // union of the headers
+	JoinInner = "inner" // intersection of the headers
+)
+
+// ConcatOptions configures Concat.
+type ConcatOptions struct {
+	// Join selects the resulting columns of a vertical concat: JoinOuter (default)
+	// keeps every header, JoinInner keeps only headers present in all frames.
+	// It is ignored for horizontal concat.
+	Join string
+}
+
+// Concat combines several Dataframes into a new one.
+// With AxisRows, frames are stacked and columns are aligned by header. Columns missing
+// from a frame are filled with nulls, and "number" columns meeting "float" columns are
+// promoted to "float". Other type mismatches are reported as errors.
+// With AxisColumns, the columns of every frame are placed side by side; all frames
+// must have the same number of rows and headers must be unique.
+// Examples:
+//
+//	jan := df.New([]series.Series[any]{series.New([]any{1, 2}, "number")}, []string{"qty"})
+//	feb := df.New([]series.Series[any]{series.New([]any{1.5}, "float")}, []string{"qty"})
+//	out, err := df.Concat([]*df.Dataframe{jan, feb}, df.AxisRows, df.ConcatOptions{})
+//	out.Debug() // qty(float): 1, 2, 1.5
+func Concat(frames []*Dataframe, axis int, opts ConcatOptions) (*Dataframe, error) {
+	for i, frame := range frames {
+		if frame == nil {
+			return nil, fmt.Errorf("concat: frame %d is nil", i)
+		}
+	}
+	switch axis {
+	case AxisRows:
+		return concatRows(frames, opts)
+	case AxisColumns:
+		return concatColumns(frames)
+	default:
+		return nil, fmt.Errorf("concat: unknown axis %d", axis)
+	}
+}
+
+// concatRows stacks frames vertically (see Concat).
+func concatRows(frames []*Dataframe, opts ConcatOptions) (*Dataframe, error) {
+	join := opts.Join
+	if join == "" {
+		join = JoinOuter
+	}
+	if join != JoinOuter && join != JoinInner {
+		return nil, fmt.Errorf("concat: unknown join %q", opts.Join)
+	}
+	for i, frame := range frames {
+		if h, ok := duplicatedHeader(frame.headers); ok {
+			return nil, fmt.Errorf("concat: frame %d has duplicated header %q", i, h)
+		}
+	}
+
+	headers := concatHeaders(frames, join)
+	out := New(nil, []string{})
+	for _, header := range headers {
+		t := ""
+		for _, frame := range frames {
+			idx := fnVisual.IndexOf(header, frame.headers)
+			if idx < 0 {
+				continue
+			}
+			colType := frame.sheet[idx].Type()
+			if t == "" {
+				t = colType
+				continue
+			}
+			promoted, err := promoteType(t, colType)
+			if err != nil {
+				return nil, fmt.Errorf("concat: column %q: %w", header, err)
+			}
+			t = promoted
+		}
+
+		var values []any
+		for _, frame := range frames {
+			idx := fnVisual.IndexOf(header, frame.headers)
+			if idx < 0 {
+				values = append(values, make([]any, frame.rows())...)
+				continue
+			}
+			col := frame.sheet[idx]
+			values = append(values, convertValues(col.ToSlice(), col.Type(), t)...)
+		}
+		out.Append(series.NewNullable(values, t), header)
+	}
+	return out, nil
+}
+
+// concatHeaders returns the headers of a vertical concat in order of first appearance.
+func concatHeaders(frames []*Dataframe, join string) []string {
+	var headers []string
+	for _, frame := range frames {
+		for _, header := range frame.headers {
+			if !is.In(header, headers) {
+				headers = append(headers, header)
+			}
+		}
+	}
+	if join == JoinOuter {
+		return headers
+	}
+	return fnVisual.Filter(headers, func(header string) bool {
+		return fnVisual.All(frames, func(frame *Dataframe) bool {
+			return is.In(header, frame.headers)
+		})
+	})
+}
+
+// concatColumns places frames side by side (see Concat).
+func concatColumns(frames []*Dataframe) (*Dataframe, error) {
+	out := New(nil, []string{})
+	for i, frame := range frames {
+		if len(frame.sheet) == 0 {
+			continue
+		}
+		if len(out.sheet) > 0 && frame.rows() != out.rows() {
+			return nil, fmt.Errorf("concat: frame %d has %d rows, expected %d", i, frame.rows(), out.rows())
+		}
+		for c, header := range frame.headers {
+			if is.In(header, out.headers) {
+				return nil, fmt.Errorf("concat: duplicated header %q", header)
+			}
+			out.Append(frame.sheet[c], header)
+		}
+	}
+	return out, nil
+}
+
+// duplicatedHeader returns the first header appearing more than once.
+func duplicatedHeader(headers []string) (string, bool) {
+	for i, header := range headers {
+		if is.In(header, headers[:i]) {
+			return header, true
+		}
+	}
+	return "", false
+}
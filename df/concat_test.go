@@ -0,0 +1,106 @@
+package df
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestDf_Concat(t *testing.T) {
+	jan := func() *Dataframe {
+		return makeDF([][]any{{"a", "b"}, {1, 2}}, []string{"string", "number"}, []string{"name", "qty"})
+	}
+	feb := func() *Dataframe {
+		return makeDF([][]any{{1.5}, {"c"}, {true}}, []string{"float", "string", "bool"}, []string{"qty", "name", "paid"})
+	}
+	testCases := []struct {
+		name            string
+		frames          []*Dataframe
+		axis            int
+		opts            ConcatOptions
+		expectedHeaders []string
+		expectedTypes   []string
+		expectedCols    [][]any
+		wantErr         bool
+	}{
+		{
+			name:            "rows outer join with promotion and null fill",
+			frames:          []*Dataframe{jan(), feb()},
+			axis:            AxisRows,
+			expectedHeaders: []string{"name", "qty", "paid"},
+			expectedTypes:   []string{"string", "float", "bool"},
+			expectedCols:    [][]any{{"a", "b", "c"}, {1.0, 2.0, 1.5}, {nil, nil, true}},
+		},
+		{
+			name:            "rows inner join",
+			frames:          []*Dataframe{jan(), feb()},
+			axis:            AxisRows,
+			opts:            ConcatOptions{Join: JoinInner},
+			expectedHeaders: []string{"name", "qty"},
+			expectedTypes:   []string{"string", "float"},
+			expectedCols:    [][]any{{"a", "b", "c"}, {1.0, 2.0, 1.5}},
+		},
+		{
+			name: "rows incompatible types",
+			frames: []*Dataframe{
+				makeDF([][]any{{1}}, []string{"number"}, []string{"x"}),
+				makeDF([][]any{{"a"}}, []string{"string"}, []string{"x"}),
+			},
+			axis:    AxisRows,
+			wantErr: true,
+		},
+		{
+			name: "columns",
+			frames: []*Dataframe{
+				makeDF([][]any{{1, 2}}, []string{"number"}, []string{"x"}),
+				makeDF([][]any{{"a", "b"}}, []string{"string"}, []string{"y"}),
+			},
+			axis:            AxisColumns,
+			expectedHeaders: []string{"x", "y"},
+			expectedTypes:   []string{"number", "string"},
+			expectedCols:    [][]any{{1, 2}, {"a", "b"}},
+		},
+		{
+			name:    "columns row count mismatch",
+			frames:  []*Dataframe{jan(), feb()},
+			axis:    AxisColumns,
+			wantErr: true,
+		},
+		{
+			name: "columns duplicated header",
+			frames: []*Dataframe{
+				makeDF([][]any{{1}}, []string{"number"}, []string{"x"}),
+				makeDF([][]any{{2}}, []string{"number"}, []string{"x"}),
+			},
+			axis:    AxisColumns,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Concat(tc.frames, tc.axis, tc.opts)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !is.SameSlice(got.GetHeaders(), tc.expectedHeaders) {
+				t.Fatalf("headers mismatch: got %v, expected %v", got.GetHeaders(), tc.expectedHeaders)
+			}
+			for i := range tc.expectedCols {
+				col, _ := got.GetSeries(i)
+				if col.Type() != tc.expectedTypes[i] {
+					t.Fatalf("col %d type mismatch: got %v, expected %v", i, col.Type(), tc.expectedTypes[i])
+				}
+				if !sliceEqualAny(col.ToSlice(), tc.expectedCols[i]) {
+					t.Fatalf("col %d mismatch: got %v, expected %v", i, col.ToSlice(), tc.expectedCols[i])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package df
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -213,6 +214,52 @@ func (df *Dataframe) Compute(t string, fn func(d *Dataframe, idx int) any) serie
 	return s
 }
 
+// ComputeErr is like Compute with a fallible function: it stops at the first error and
+// returns it as an *fn.IndexError holding the row index.
+// Examples:
+//
+//	out, err := df.ComputeErr("number", func(d *df.Dataframe, i int) (any, error) {
+//		raw, _ := d.GetSeriesByHeader("raw")
+//		return strconv.Atoi(raw.GetValue(i).(string))
+//	})
+func (df *Dataframe) ComputeErr(t string, fn func(d *Dataframe, idx int) (any, error)) (series.Series[any], error) {
+	rows := df.rows()
+	slice := make([]any, rows)
+	s := series.New(slice, t)
+	for i := 0; i < rows; i++ {
+		value, err := fn(df, i)
+		if err != nil {
+			return series.Series[any]{}, &fnVisual.IndexError{Index: i, Err: err}
+		}
+		s = s.SetValue(i, value)
+	}
+	return s, nil
+}
+
+// ComputeErrAll is like ComputeErr but calls fn on every row, whatever the errors: failed
+// rows are null and every error is returned as an *fn.IndexError holding the row index,
+// joined with errors.Join.
+// Examples:
+//
+//	out, err := df.ComputeErrAll("number", func(d *df.Dataframe, i int) (any, error) {
+//		raw, _ := d.GetSeriesByHeader("raw")
+//		return strconv.Atoi(raw.GetValue(i).(string))
+//	}) // raw ["1", "x", "y"] gives out: [1, <nil>, <nil>], err: index 1: ...\nindex 2: ...
+func (df *Dataframe) ComputeErrAll(t string, fn func(d *Dataframe, idx int) (any, error)) (series.Series[any], error) {
+	rows := df.rows()
+	s := series.NewNullable(make([]any, rows), t)
+	var errs []error
+	for i := 0; i < rows; i++ {
+		value, err := fn(df, i)
+		if err != nil {
+			errs = append(errs, &fnVisual.IndexError{Index: i, Err: err})
+			continue
+		}
+		s = s.SetValue(i, value)
+	}
+	return s, errors.Join(errs...)
+}
+
 // Debug prints the dataframe to stdout in a simple aligned table format.
 // It includes headers with their types and all rows. For an empty dataframe,
 // it prints a placeholder line.
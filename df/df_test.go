@@ -1,10 +1,14 @@
 package df
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
+	fnVisual "github.com/visual-pivert/go-starter/fn"
 	"github.com/visual-pivert/go-starter/is"
 	"github.com/visual-pivert/go-starter/series"
 )
@@ -302,3 +306,55 @@ func TestDf_Compute(t *testing.T) {
 		})
 	}
 }
+
+func TestDf_ComputeErr(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      []any
+		expected []any
+		wantErr  string
+	}{
+		{name: "parse every row", raw: []any{"1", "22"}, expected: []any{1, 22}},
+		{name: "stop at the failing row", raw: []any{"1", "x", "y"}, wantErr: "index 1: "},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := makeDF([][]any{tc.raw}, []string{"string"}, []string{"raw"})
+			res, err := df.ComputeErr("number", func(d *Dataframe, idx int) (any, error) {
+				raw, _ := d.GetSeriesByHeader("raw")
+				return strconv.Atoi(raw.GetValue(idx).(string))
+			})
+			if tc.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error starting with %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil || res.Type() != "number" || !reflect.DeepEqual(res.ToSlice(), tc.expected) {
+				t.Fatalf("compute result mismatch: got %v (%v), expected %v", res.ToSlice(), err, tc.expected)
+			}
+		})
+	}
+	if res, err := New(nil, []string{}).ComputeErr("number", nil); err != nil || res.Len() != 0 {
+		t.Errorf("expected an empty result, got %v (%v)", res.ToSlice(), err)
+	}
+}
+
+func TestDf_ComputeErrAll(t *testing.T) {
+	df := makeDF([][]any{{"1", "x", "3", "y"}}, []string{"string"}, []string{"raw"})
+	res, err := df.ComputeErrAll("number", func(d *Dataframe, idx int) (any, error) {
+		raw, _ := d.GetSeriesByHeader("raw")
+		return strconv.Atoi(raw.GetValue(idx).(string))
+	})
+	if res.Type() != "number" || !reflect.DeepEqual(res.ToSlice(), []any{1, nil, 3, nil}) {
+		t.Errorf("compute result mismatch: got %v %v", res.Type(), res.ToSlice())
+	}
+	var indexErr *fnVisual.IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 || !strings.Contains(err.Error(), "\nindex 3: ") {
+		t.Errorf("expected errors at rows 1 and 3, got %v", err)
+	}
+	if _, err := New(nil, []string{}).ComputeErrAll("number", nil); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package df
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// EqualOptions controls how Equals and Diff compare two Dataframes.
+type EqualOptions struct {
+	// IgnoreColumnOrder matches columns by header instead of by position.
+	IgnoreColumnOrder bool
+	// IgnoreRowOrder sorts the rows of both Dataframes on all their columns before comparing
+	// them, so rows are paired on their formatted values (Tolerance does not apply to the sort).
+	IgnoreRowOrder bool
+	// IgnoreTypes skips the type tag check; numbers and floats then compare by value.
+	IgnoreTypes bool
+	// Tolerance is the largest difference between two floats that are considered equal.
+	Tolerance float64
+}
+
+// Equals returns true if the Dataframe has the same headers, type tags and values as
+// other, within the given options (see Diff).
+// Examples:
+//
+//	same := got.Equals(expected, df.EqualOptions{IgnoreRowOrder: true})
+func (df *Dataframe) Equals(other *Dataframe, opts EqualOptions) bool {
+	return Diff(df, other, opts) == ""
+}
+
+// Diff returns a human-readable report of the differences between two Dataframes, one per
+// line: missing columns, type tags, row counts and mismatched cells. Rows are numbered as
+// in the first Dataframe. The report is empty when the Dataframes are equal.
+// Examples:
+//
+//	if report := df.Diff(got, expected, df.EqualOptions{}); report != "" {
+//		t.Errorf("dataframes differ:\n%s", report)
+//	}
+//	// row 1, column "age": 31 != 32
+//	// column "name": type string != number
+func Diff(a *Dataframe, b *Dataframe, opts EqualOptions) string {
+	var lines []string
+	report := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	// pairs of column indices to compare, in the column order of a
+	var pairs [][2]int
+	if opts.IgnoreColumnOrder {
+		for i, header := range a.headers {
+			if j := fnVisual.IndexOf(header, b.headers); j >= 0 {
+				pairs = append(pairs, [2]int{i, j})
+			} else {
+				report("column %q missing from the second Dataframe", header)
+			}
+		}
+		for _, header := range b.headers {
+			if !is.In(header, a.headers) {
+				report("column %q missing from the first Dataframe", header)
+			}
+		}
+	} else {
+		for i := range max(len(a.headers), len(b.headers)) {
+			switch {
+			case i >= len(b.headers):
+				report("column %d %q missing from the second Dataframe", i, a.headers[i])
+			case i >= len(a.headers):
+				report("column %d %q missing from the first Dataframe", i, b.headers[i])
+			case a.headers[i] != b.headers[i]:
+				report("column %d: header %q != %q", i, a.headers[i], b.headers[i])
+			default:
+				pairs = append(pairs, [2]int{i, i})
+			}
+		}
+	}
+
+	if !opts.IgnoreTypes {
+		for _, p := range pairs {
+			if ta, tb := a.sheet[p[0]].Type(), b.sheet[p[1]].Type(); ta != tb {
+				report("column %q: type %s != %s", a.headers[p[0]], ta, tb)
+			}
+		}
+	}
+
+	rowsA, rowsB := a.rows(), b.rows()
+	if rowsA != rowsB {
+		report("rows: %d != %d", rowsA, rowsB)
+	}
+	orderA := rowOrder(a, fnVisual.Map(pairs, func(p [2]int, _ int) int { return p[0] }), opts.IgnoreRowOrder)
+	orderB := rowOrder(b, fnVisual.Map(pairs, func(p [2]int, _ int) int { return p[1] }), opts.IgnoreRowOrder)
+	for r := range min(rowsA, rowsB) {
+		for _, p := range pairs {
+			va := a.sheet[p[0]].GetValue(orderA[r])
+			vb := b.sheet[p[1]].GetValue(orderB[r])
+			if !cellEqual(va, vb, opts) {
+				report("row %d, column %q: %s != %s", orderA[r], a.headers[p[0]], formatCell(va), formatCell(vb))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rowOrder returns the row indices of df, sorted on the given columns when sorted is true.
+func rowOrder(df *Dataframe, columns []int, sorted bool) []int {
+	order := make([]int, df.rows())
+	for i := range order {
+		order[i] = i
+	}
+	if !sorted {
+		return order
+	}
+	keys := make([]string, len(order))
+	for r := range keys {
+		parts := make([]string, len(columns))
+		for i, c := range columns {
+			parts[i] = formatCell(df.sheet[c].GetValue(r))
+		}
+		keys[r] = strings.Join(parts, "\x00")
+	}
+	slices.SortStableFunc(order, func(x int, y int) int { return strings.Compare(keys[x], keys[y]) })
+	return order
+}
+
+// cellEqual compares two cells with is.EqualWithin; when types are ignored, numeric
+// values of different Go types compare by value, and a time.Time compares with a date
+// string as a date.
+func cellEqual(a any, b any, opts EqualOptions) bool {
+	if opts.IgnoreTypes {
+		fa, okA := series.ToFloat(a)
+		fb, okB := series.ToFloat(b)
+		if okA && okB {
+			return is.EqualWithin(fa, fb, opts.Tolerance)
+		}
+		_, dateA := a.(time.Time)
+		_, dateB := b.(time.Time)
+		if dateA || dateB {
+			ta, errA := series.ParseDate(a)
+			tb, errB := series.ParseDate(b)
+			if errA == nil && errB == nil {
+				return ta.Equal(tb)
+			}
+		}
+	}
+	return is.EqualWithin(a, b, opts.Tolerance)
+}
+
+// formatCell formats a cell for a Diff report: null, quoted strings, RFC 3339 dates and
+// floats with a decimal point, so that 31 and 31.0 read differently.
+func formatCell(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(value)
+	case time.Time:
+		return value.Format(time.RFC3339Nano)
+	case float32:
+		return formatFloat(float64(value), 32)
+	case float64:
+		return formatFloat(value, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// formatFloat formats f in its shortest form, adding ".0" to integral values.
+func formatFloat(f float64, bitSize int) string {
+	s := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
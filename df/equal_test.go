@@ -0,0 +1,84 @@
+package df
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestDf_Diff(t *testing.T) {
+	base := func() *Dataframe {
+		return makeDF([][]any{{"Ana", "Bob"}, {31, 40}, {1.5, 2.5}}, []string{"string", "number", "float"}, []string{"name", "age", "score"})
+	}
+	testCases := []struct {
+		name     string
+		other    *Dataframe
+		opts     EqualOptions
+		expected string
+	}{
+		{name: "equal", other: base(), expected: ""},
+		{
+			name:     "mismatched cell",
+			other:    makeDF([][]any{{"Ana", "Bob"}, {31, 41}, {1.5, 2.5}}, []string{"string", "number", "float"}, []string{"name", "age", "score"}),
+			expected: `row 1, column "age": 40 != 41`,
+		},
+		{
+			name:     "column order",
+			other:    makeDF([][]any{{31, 40}, {"Ana", "Bob"}, {1.5, 2.5}}, []string{"number", "string", "float"}, []string{"age", "name", "score"}),
+			expected: "column 0: header \"name\" != \"age\"\ncolumn 1: header \"age\" != \"name\"",
+		},
+		{
+			name:  "ignore column order",
+			other: makeDF([][]any{{31, 40}, {"Ana", "Bob"}, {1.5, 2.5}}, []string{"number", "string", "float"}, []string{"age", "name", "score"}),
+			opts:  EqualOptions{IgnoreColumnOrder: true},
+		},
+		{
+			name:  "ignore row order",
+			other: makeDF([][]any{{"Bob", "Ana"}, {40, 31}, {2.5, 1.5}}, []string{"string", "number", "float"}, []string{"name", "age", "score"}),
+			opts:  EqualOptions{IgnoreRowOrder: true},
+		},
+		{
+			name:     "type tags",
+			other:    makeDF([][]any{{"Ana", "Bob"}, {31.0, 40.0}, {1.5, 2.5}}, []string{"string", "float", "float"}, []string{"name", "age", "score"}),
+			expected: "column \"age\": type number != float\nrow 0, column \"age\": 31 != 31.0\nrow 1, column \"age\": 40 != 40.0",
+		},
+		{
+			name:  "ignore type tags",
+			other: makeDF([][]any{{"Ana", "Bob"}, {31.0, 40.0}, {1.5, 2.5}}, []string{"string", "float", "float"}, []string{"name", "age", "score"}),
+			opts:  EqualOptions{IgnoreTypes: true},
+		},
+		{
+			name:  "tolerance",
+			other: makeDF([][]any{{"Ana", "Bob"}, {31, 40}, {1.5001, 2.5}}, []string{"string", "number", "float"}, []string{"name", "age", "score"}),
+			opts:  EqualOptions{Tolerance: 1e-3},
+		},
+		{
+			name:     "missing column and row",
+			other:    makeDF([][]any{{"Ana"}, {31}}, []string{"string", "number"}, []string{"name", "age"}),
+			opts:     EqualOptions{IgnoreColumnOrder: true},
+			expected: "column \"score\" missing from the second Dataframe\nrows: 2 != 1",
+		},
+		{
+			name:     "empty",
+			other:    New(nil, nil),
+			expected: "column 0 \"name\" missing from the second Dataframe\ncolumn 1 \"age\" missing from the second Dataframe\ncolumn 2 \"score\" missing from the second Dataframe\nrows: 2 != 0",
+		},
+		{
+			name:     "null and string",
+			other:    New([]series.Series[any]{series.NewNullable([]any{"Ana", nil}, "string"), series.New([]any{31, 40}, "number"), series.New([]any{1.5, 2.5}, "float")}, []string{"name", "age", "score"}),
+			expected: `row 1, column "name": "Bob" != null`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Diff(base(), tc.other, tc.opts)
+			if got != tc.expected {
+				t.Fatalf("report mismatch:\ngot:\n%s\nexpected:\n%s", got, tc.expected)
+			}
+			if base().Equals(tc.other, tc.opts) != (tc.expected == "") {
+				t.Fatalf("Equals disagrees with the report %q", got)
+			}
+		})
+	}
+}
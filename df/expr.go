@@ -0,0 +1,322 @@
+package df
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token kinds produced by the expression lexer.
+const (
+	tokEOF    = "EOF"
+	tokIdent  = "identifier"
+	tokNumber = "number"
+	tokString = "string"
+	tokOp     = "operator"
+)
+
+type token struct {
+	kind   string
+	text   string
+	value  any // decoded literal for tokNumber and tokString
+	pos    int
+	quoted bool // backquoted identifier, never a keyword
+}
+
+// operators lists the symbolic operators, longest first so that "<=" wins over "<".
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "!", "(", ")", ","}
+
+// lex splits an expression into tokens.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c == '\'' || c == '"':
+			s, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("at offset %d: %w", i, err)
+			}
+			tokens = append(tokens, token{tokString, src[i : i+n], s, i, false})
+			i += n
+		case c == '`':
+			end := strings.IndexByte(src[i+1:], '`')
+			if end < 0 {
+				return nil, fmt.Errorf("at offset %d: unterminated quoted identifier", i)
+			}
+			tokens = append(tokens, token{tokIdent, src[i+1 : i+1+end], nil, i, true})
+			i += end + 2
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			text := src[start:i]
+			var value any
+			var err error
+			if strings.Contains(text, ".") {
+				value, err = strconv.ParseFloat(text, 64)
+			} else {
+				value, err = strconv.Atoi(text)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("at offset %d: invalid number %q", start, text)
+			}
+			tokens = append(tokens, token{tokNumber, text, value, start, false})
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(src) {
+				r, n := utf8.DecodeRuneInString(src[i:])
+				if r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += n
+			}
+			tokens = append(tokens, token{tokIdent, src[start:i], nil, start, false})
+		default:
+			op := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(src[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("at offset %d: unexpected character %q", i, c)
+			}
+			tokens = append(tokens, token{tokOp, op, nil, i, false})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{tokEOF, "", nil, len(src), false}), nil
+}
+
+// lexString decodes a quoted string literal at the start of src and returns it with
+// the number of bytes consumed. A backslash escapes the next character.
+func lexString(src string) (string, int, error) {
+	quote := src[0]
+	var b strings.Builder
+	for i := 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			if i+1 < len(src) {
+				i++
+				b.WriteByte(src[i])
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(src[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// parser is a recursive descent parser over the tokens of an expression.
+// Grammar, from lowest to highest precedence:
+//
+//	or      = and { "||" and }
+//	and     = not { "&&" not }
+//	not     = "!" not | compare
+//	compare = sum [ ("==" | "!=" | "<" | "<=" | ">" | ">=") sum | [ "not" ] "in" "(" list ")" ]
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/" | "%") unary }
+//	unary   = "-" unary | primary
+//	primary = number | string | "true" | "false" | "null" | identifier | "(" or ")"
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parseExpr parses an expression into its syntax tree.
+func parseExpr(src string) (exprNode, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("at offset %d: unexpected %q", tok.pos, tok.text)
+	}
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given operators or keywords.
+func (p *parser) accept(texts ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokOp && (tok.kind != tokIdent || tok.quoted) {
+		return "", false
+	}
+	for _, text := range texts {
+		if tok.text == text {
+			p.pos++
+			return text, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expect(text string) error {
+	if _, ok := p.accept(text); !ok {
+		tok := p.peek()
+		return fmt.Errorf("at offset %d: expected %q, got %q", tok.pos, text, tok.text)
+	}
+	return nil
+}
+
+func (p *parser) parseBinary(operand func() (exprNode, error), ops ...string) (exprNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		pos := p.peek().pos
+		op, ok := p.accept(ops...)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right, pos: pos}
+	}
+}
+
+func (p *parser) parseOr() (exprNode, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (exprNode, error) {
+	return p.parseBinary(p.parseNot, "&&")
+}
+
+func (p *parser) parseNot() (exprNode, error) {
+	pos := p.peek().pos
+	if _, ok := p.accept("!"); ok {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "!", operand: operand, pos: pos}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (exprNode, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	pos := p.peek().pos
+	if op, ok := p.accept("==", "!=", "<", "<=", ">", ">="); ok {
+		right, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: op, left: left, right: right, pos: pos}, nil
+	}
+	_, negate := p.accept("not")
+	if _, ok := p.accept("in"); !ok {
+		if negate {
+			return nil, fmt.Errorf("at offset %d: expected \"in\" after \"not\"", pos)
+		}
+		return left, nil
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var list []exprNode
+	for {
+		item, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, item)
+		if _, ok := p.accept(","); !ok {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return &inNode{operand: left, list: list, negate: negate, pos: pos}, nil
+}
+
+func (p *parser) parseSum() (exprNode, error) {
+	return p.parseBinary(p.parseProduct, "+", "-")
+}
+
+func (p *parser) parseProduct() (exprNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseUnary() (exprNode, error) {
+	pos := p.peek().pos
+	if _, ok := p.accept("-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "-", operand: operand, pos: pos}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		if _, ok := tok.value.(int); ok {
+			return &literalNode{value: tok.value, t: "number"}, nil
+		}
+		return &literalNode{value: tok.value, t: "float"}, nil
+	case tokString:
+		return &literalNode{value: tok.value, t: "string"}, nil
+	case tokIdent:
+		switch {
+		case tok.quoted:
+		case tok.text == "true" || tok.text == "false":
+			return &literalNode{value: tok.text == "true", t: "bool"}, nil
+		case tok.text == "null":
+			return &literalNode{value: nil, t: "null"}, nil
+		}
+		return &columnNode{name: tok.text, pos: tok.pos}, nil
+	case tokOp:
+		if tok.text == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		}
+	}
+	if tok.kind == tokEOF {
+		return nil, fmt.Errorf("at offset %d: unexpected end of expression", tok.pos)
+	}
+	return nil, fmt.Errorf("at offset %d: unexpected %q", tok.pos, tok.text)
+}
@@ -1,6 +1,34 @@
 package df
 
-import "github.com/visual-pivert/go-starter/series"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// RawOptions configures ParseRaw.
+type RawOptions struct {
+	// Nulls makes blank cells nulls, and cells that do not convert to the type of their
+	// column nulls too. Otherwise the cells are converted by series.New.
+	Nulls bool
+	// Strict makes blank cells nulls and a cell that does not convert to the type of its
+	// column a *RawError.
+	Strict bool
+}
+
+// RawError is returned by ParseRaw in strict mode for a cell that does not convert to the
+// type of its column.
+type RawError struct {
+	Row    int    // index of the row in the raw data, header row included
+	Column string // header of the column
+	Value  string // raw cell
+	Type   string // type tag of the column
+}
+
+func (e *RawError) Error() string {
+	return fmt.Sprintf("row %d, column %q: cannot parse %q as %s", e.Row, e.Column, e.Value, e.Type)
+}
 
 // FromRaw creates a dataframe from raw data.
 // Example:
@@ -18,8 +46,20 @@ import "github.com/visual-pivert/go-starter/series"
 // | 1 | 2 | 3 |
 // | 4 | 5 | 6 |
 func FromRaw(data [][]string, types []string, headerId int) *Dataframe {
+	out, _ := ParseRaw(data, types, headerId, RawOptions{})
+	return out
+}
+
+// ParseRaw creates a dataframe from raw data like FromRaw, with nulls for blank cells
+// and errors for the cells that do not convert to the type of their column, depending on
+// opts. Cells are converted as by series.Cast.
+// Examples:
+//
+//	df.ParseRaw([][]string{{"id", "day"}, {"1", ""}, {"x", "2024-03-01"}}, []string{"number", "date"}, 0,
+//		df.RawOptions{Strict: true}) // nil, row 2, column "id": cannot parse "x" as number
+func ParseRaw(data [][]string, types []string, headerId int, opts RawOptions) (*Dataframe, error) {
 	if len(data) == 0 || headerId < 0 || headerId >= len(data) {
-		return New(nil, []string{})
+		return New(nil, []string{}), nil
 	}
 
 	headers := data[headerId]
@@ -27,7 +67,7 @@ func FromRaw(data [][]string, types []string, headerId int) *Dataframe {
 
 	startRow := headerId + 1
 	if startRow > len(data) {
-		return New(nil, headers)
+		return New(nil, headers), nil
 	}
 	rows := len(data) - startRow
 
@@ -54,7 +94,20 @@ func FromRaw(data [][]string, types []string, headerId int) *Dataframe {
 
 	newDf := New(nil, []string{})
 	for idx, col := range dataframe {
-		newDf.Append(series.New[any](col, types[idx]), headers[idx])
+		if !opts.Nulls && !opts.Strict {
+			newDf.Append(series.New[any](col, types[idx]), headers[idx])
+			continue
+		}
+		s, err := series.New[any](col, "string").Cast(types[idx], series.CastOptions{Strict: opts.Strict})
+		var castErr *series.CastError
+		if errors.As(err, &castErr) {
+			r := castErr.Indices[0]
+			return nil, &RawError{Row: startRow + r, Column: headers[idx], Value: col[r].(string), Type: types[idx]}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", headers[idx], err)
+		}
+		newDf.Append(s, headers[idx])
 	}
-	return newDf
+	return newDf, nil
 }
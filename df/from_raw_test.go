@@ -1,9 +1,12 @@
 package df
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
 )
 
 func TestDf_FromRaw(t *testing.T) {
@@ -42,3 +45,45 @@ func TestDf_FromRaw(t *testing.T) {
 	}
 
 }
+
+func TestDf_ParseRaw(t *testing.T) {
+	raw := [][]string{
+		{"title"},
+		{"id", "day"},
+		{"1", ""},
+		{"x", "2024-03-01"},
+		{"3"},
+	}
+	types := []string{"number", "date"}
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		opts     RawOptions
+		expected *Dataframe
+		wantErr  string
+	}{
+		{"nulls", RawOptions{Nulls: true}, New([]series.Series[any]{
+			series.NewNullable([]any{1, nil, 3}, "number"),
+			series.NewNullable([]any{nil, day, nil}, "date"),
+		}, []string{"id", "day"}), ""},
+		{"strict", RawOptions{Strict: true}, nil, `row 3, column "id": cannot parse "x" as number`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := ParseRaw(raw, types, 1, tc.opts)
+			if tc.wantErr != "" {
+				var rawErr *RawError
+				if !errors.As(err, &rawErr) || err.Error() != tc.wantErr {
+					tt.Fatalf("expected the error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := Diff(got, tc.expected, EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package df
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// JSON orientations accepted by ToJSON.
+const (
+	// OrientRecords encodes a list of row objects: [{"a":1,"b":"x"},...].
+	OrientRecords = "records"
+	// OrientColumns encodes an object of column arrays: {"a":[1,...],"b":["x",...]}.
+	OrientColumns = "columns"
+	// OrientSplit encodes headers and rows apart: {"columns":["a","b"],"data":[[1,"x"],...]}.
+	OrientSplit = "split"
+	// OrientTable encodes the type tags along with the rows:
+	// {"schema":{"fields":[{"name":"a","type":"number"},...]},"data":[{"a":1,"b":"x"},...]}.
+	OrientTable = "table"
+)
+
+// jsonField describes a column in the table orientation.
+type jsonField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes the Dataframe in the table orientation (see ToJSON).
+func (df *Dataframe) MarshalJSON() ([]byte, error) {
+	return df.ToJSON(OrientTable)
+}
+
+// ToJSON encodes the Dataframe in one of the orientations OrientRecords, OrientColumns,
+// OrientSplit or OrientTable. Values are encoded as by series.JSONValue: dates as RFC 3339
+// strings, nulls as JSON null. Object keys follow the column order. Only the table
+// orientation keeps the type tags; all but the split orientation need unique headers.
+// Examples:
+//
+//	b, err := df.ToJSON(df.OrientRecords) // [{"name":"Ana","age":31},{"name":"Bob","age":null}]
+func (df *Dataframe) ToJSON(orient string) ([]byte, error) {
+	if orient != OrientSplit {
+		if header, ok := duplicatedHeader(df.headers); ok {
+			return nil, fmt.Errorf("json: duplicated header %q in %s orientation", header, orient)
+		}
+	}
+	var buf bytes.Buffer
+	var err error
+	switch orient {
+	case OrientRecords:
+		err = df.writeRecords(&buf)
+	case OrientColumns:
+		buf.WriteByte('{')
+		for c, header := range df.headers {
+			if c > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Quote(header) + ":[")
+			for r := range df.rows() {
+				if r > 0 {
+					buf.WriteByte(',')
+				}
+				if err = df.writeCell(&buf, c, r); err != nil {
+					return nil, err
+				}
+			}
+			buf.WriteByte(']')
+		}
+		buf.WriteByte('}')
+	case OrientSplit:
+		headers, _ := json.Marshal(df.headers)
+		buf.WriteString(`{"columns":`)
+		buf.Write(headers)
+		buf.WriteString(`,"data":[`)
+		for r := range df.rows() {
+			if r > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('[')
+			for c := range df.sheet {
+				if c > 0 {
+					buf.WriteByte(',')
+				}
+				if err = df.writeCell(&buf, c, r); err != nil {
+					return nil, err
+				}
+			}
+			buf.WriteByte(']')
+		}
+		buf.WriteString("]}")
+	case OrientTable:
+		fields := make([]jsonField, len(df.headers))
+		for c, header := range df.headers {
+			fields[c] = jsonField{header, df.sheet[c].Type()}
+		}
+		schema, _ := json.Marshal(fields)
+		buf.WriteString(`{"schema":{"fields":`)
+		buf.Write(schema)
+		buf.WriteString(`},"data":`)
+		err = df.writeRecords(&buf)
+		buf.WriteByte('}')
+	default:
+		return nil, fmt.Errorf("json: unknown orientation %q", orient)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeRecords writes the rows as a JSON array of objects.
+func (df *Dataframe) writeRecords(buf *bytes.Buffer) error {
+	buf.WriteByte('[')
+	for r := range df.rows() {
+		if r > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for c, header := range df.headers {
+			if c > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Quote(header) + ":")
+			if err := df.writeCell(buf, c, r); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// writeCell writes the JSON value of the cell at column c and row r.
+func (df *Dataframe) writeCell(buf *bytes.Buffer, c int, r int) error {
+	b, err := json.Marshal(series.JSONValue(df.sheet[c].GetValue(r), df.sheet[c].Type()))
+	if err != nil {
+		return fmt.Errorf("json: row %d, column %q: %w", r, df.headers[c], err)
+	}
+	buf.Write(b)
+	return nil
+}
+
+// UnmarshalJSON decodes a Dataframe from any orientation of ToJSON, detected from the
+// shape of the document: an array is the records orientation, an object with exactly a
+// "schema" object holding "fields" and a "data" member is the table orientation, an object
+// with exactly a "columns" array of strings and a "data" array of arrays is the split
+// orientation and any other object is the columns orientation. Use FromJSON for frames
+// whose headers would match another orientation. The table orientation restores the type
+// tags exactly; for the other orientations each column type is inferred from its values
+// (see series.InferJSON).
+// Examples:
+//
+//	var d df.Dataframe
+//	err := json.Unmarshal([]byte(`{"a":[1,2],"b":["x",null]}`), &d) // number column a, string column b
+func (df *Dataframe) UnmarshalJSON(data []byte) error {
+	orient, err := detectOrient(data)
+	if err != nil {
+		return err
+	}
+	return df.decodeJSON(data, orient)
+}
+
+// FromJSON decodes a Dataframe encoded by ToJSON in the given orientation, without
+// guessing it like json.Unmarshal does.
+// Examples:
+//
+//	// a columns orientation that json.Unmarshal would read as the split orientation
+//	d, err := df.FromJSON([]byte(`{"columns":["a","b"],"data":[[1],[2]]}`), df.OrientColumns)
+func FromJSON(data []byte, orient string) (*Dataframe, error) {
+	out := New(nil, []string{})
+	if err := out.decodeJSON(data, orient); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// detectOrient returns the orientation of a JSON document (see UnmarshalJSON).
+func detectOrient(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return OrientRecords, nil
+	}
+	keys, members, err := decodeObject(data)
+	if err != nil {
+		return "", fmt.Errorf("json: %w", err)
+	}
+	if len(keys) != 2 || members["data"] == nil {
+		return OrientColumns, nil
+	}
+	var schema map[string]json.RawMessage
+	if members["schema"] != nil && json.Unmarshal(members["schema"], &schema) == nil && schema["fields"] != nil {
+		return OrientTable, nil
+	}
+	var headers []string
+	var rows [][]json.RawMessage
+	if members["columns"] != nil && json.Unmarshal(members["columns"], &headers) == nil && json.Unmarshal(members["data"], &rows) == nil {
+		return OrientSplit, nil
+	}
+	return OrientColumns, nil
+}
+
+// decodeJSON replaces the content of df with a JSON document in the given orientation.
+func (df *Dataframe) decodeJSON(data []byte, orient string) error {
+	if orient == OrientRecords {
+		headers, columns, err := decodeRecords(data, nil)
+		if err != nil {
+			return err
+		}
+		return df.setJSONColumns(headers, columns, nil)
+	}
+	if orient != OrientColumns && orient != OrientSplit && orient != OrientTable {
+		return fmt.Errorf("json: unknown orientation %q", orient)
+	}
+	keys, members, err := decodeObject(data)
+	if err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+	switch orient {
+	case OrientTable:
+		var schema struct {
+			Fields []jsonField `json:"fields"`
+		}
+		if err := json.Unmarshal(members["schema"], &schema); err != nil {
+			return fmt.Errorf("json: schema: %w", err)
+		}
+		headers := make([]string, len(schema.Fields))
+		types := make([]string, len(schema.Fields))
+		for i, field := range schema.Fields {
+			headers[i], types[i] = field.Name, field.Type
+		}
+		_, columns, err := decodeRecords(members["data"], headers)
+		if err != nil {
+			return err
+		}
+		return df.setJSONColumns(headers, columns, types)
+	case OrientSplit:
+		var headers []string
+		var rows [][]json.RawMessage
+		if err := json.Unmarshal(members["columns"], &headers); err != nil {
+			return fmt.Errorf("json: columns: %w", err)
+		}
+		if err := json.Unmarshal(members["data"], &rows); err != nil {
+			return fmt.Errorf("json: data: %w", err)
+		}
+		columns := make([][]json.RawMessage, len(headers))
+		for r, row := range rows {
+			if len(row) != len(headers) {
+				return fmt.Errorf("json: row %d: expected %d values, got %d", r, len(headers), len(row))
+			}
+			for c := range headers {
+				columns[c] = append(columns[c], row[c])
+			}
+		}
+		return df.setJSONColumns(headers, columns, nil)
+	default:
+		columns := make([][]json.RawMessage, len(keys))
+		for c, key := range keys {
+			if err := json.Unmarshal(members[key], &columns[c]); err != nil {
+				return fmt.Errorf("json: column %q: %w", key, err)
+			}
+			if len(columns[c]) != len(columns[0]) {
+				return fmt.Errorf("json: column %q: expected %d values, got %d", key, len(columns[0]), len(columns[c]))
+			}
+		}
+		return df.setJSONColumns(keys, columns, nil)
+	}
+}
+
+// decodeObject decodes a JSON object and returns its keys in document order with their raw values.
+func decodeObject(data []byte) ([]string, map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if tok != json.Delim('{') {
+		return nil, nil, fmt.Errorf("expected an object or an array, got %v", tok)
+	}
+	var keys []string
+	members := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := members[key]; !ok {
+			keys = append(keys, key)
+		}
+		members[key] = raw
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, members, nil
+}
+
+// decodeRecords decodes a JSON array of row objects into columns of raw values; missing
+// keys are null. The columns are headers when given (other keys are an error), otherwise
+// the union of the keys in order of first appearance.
+func decodeRecords(data []byte, headers []string) ([]string, [][]json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, fmt.Errorf("json: %w", err)
+	}
+	fixed := headers != nil
+	records := make([]map[string]json.RawMessage, len(items))
+	for r, item := range items {
+		keys, members, err := decodeObject(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("json: record %d: %w", r, err)
+		}
+		for _, key := range keys {
+			if is.In(key, headers) {
+				continue
+			}
+			if fixed {
+				return nil, nil, fmt.Errorf("json: record %d: column %q not in schema", r, key)
+			}
+			headers = append(headers, key)
+		}
+		records[r] = members
+	}
+	columns := make([][]json.RawMessage, len(headers))
+	for c, header := range headers {
+		columns[c] = make([]json.RawMessage, len(records))
+		for r, record := range records {
+			columns[c][r] = record[header]
+		}
+	}
+	return headers, columns, nil
+}
+
+// setJSONColumns replaces the content of df with columns of raw JSON values. Column types
+// are given by types, or inferred when types is nil.
+func (df *Dataframe) setJSONColumns(headers []string, columns [][]json.RawMessage, types []string) error {
+	sheet := make([]series.Series[any], len(headers))
+	for c, column := range columns {
+		var values []any
+		var err error
+		if types != nil {
+			values, err = decodeTypedColumn(column, types[c])
+			if err == nil {
+				sheet[c] = series.NewNullable(values, types[c])
+			}
+		} else {
+			sheet[c], err = decodeUntypedColumn(column)
+		}
+		if err != nil {
+			return fmt.Errorf("json: column %q: %w", headers[c], err)
+		}
+	}
+	df.sheet, df.headers = sheet, headers
+	return nil
+}
+
+// decodeTypedColumn decodes raw values as values of type t (see series.DecodeJSONValue).
+func decodeTypedColumn(column []json.RawMessage, t string) ([]any, error) {
+	values := make([]any, len(column))
+	for r, raw := range column {
+		if raw == nil {
+			continue
+		}
+		v, err := series.DecodeJSONValue(raw, t)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", r, err)
+		}
+		values[r] = v
+	}
+	return values, nil
+}
+
+// decodeUntypedColumn decodes raw values and infers the column type (see
+// series.InferJSON); nested objects and arrays are kept as their JSON text.
+func decodeUntypedColumn(column []json.RawMessage) (series.Series[any], error) {
+	values := make([]any, len(column))
+	for r, raw := range column {
+		if raw == nil {
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		if err := dec.Decode(&values[r]); err != nil {
+			return series.Series[any]{}, fmt.Errorf("row %d: %w", r, err)
+		}
+		switch values[r].(type) {
+		case map[string]any, []any:
+			values[r] = string(bytes.TrimSpace(raw))
+		}
+	}
+	return series.InferJSON(values), nil
+}
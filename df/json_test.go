@@ -0,0 +1,193 @@
+package df
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func jsonFixture() *Dataframe {
+	return New([]series.Series[any]{
+		series.New([]any{"Ana", "Bob"}, "string"),
+		series.NewNullable([]any{31, nil}, "number"),
+		series.New([]any{1.5, 2.0}, "float"),
+		series.New([]any{true, false}, "bool"),
+		series.NewNullable([]any{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil}, "date"),
+	}, []string{"name", "age", "score", "active", "since"})
+}
+
+func TestDf_ToJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		orient   string
+		expected string
+	}{
+		{"records", OrientRecords, `[{"name":"Ana","age":31,"score":1.5,"active":true,"since":"2024-01-02T00:00:00Z"},{"name":"Bob","age":null,"score":2,"active":false,"since":null}]`},
+		{"columns", OrientColumns, `{"name":["Ana","Bob"],"age":[31,null],"score":[1.5,2],"active":[true,false],"since":["2024-01-02T00:00:00Z",null]}`},
+		{"split", OrientSplit, `{"columns":["name","age","score","active","since"],"data":[["Ana",31,1.5,true,"2024-01-02T00:00:00Z"],["Bob",null,2,false,null]]}`},
+		{"table", OrientTable, `{"schema":{"fields":[{"name":"name","type":"string"},{"name":"age","type":"number"},{"name":"score","type":"float"},{"name":"active","type":"bool"},{"name":"since","type":"date"}]},"data":[{"name":"Ana","age":31,"score":1.5,"active":true,"since":"2024-01-02T00:00:00Z"},{"name":"Bob","age":null,"score":2,"active":false,"since":null}]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := jsonFixture().ToJSON(tc.orient)
+			if err != nil || string(got) != tc.expected {
+				t.Fatalf("got %s (%v), expected %s", got, err, tc.expected)
+			}
+			if !json.Valid(got) {
+				t.Fatalf("invalid JSON %s", got)
+			}
+		})
+	}
+}
+
+func TestDf_ToJSONErrors(t *testing.T) {
+	dup := makeDF([][]any{{1}, {2}}, []string{"number", "number"}, []string{"a", "a"})
+	if _, err := dup.ToJSON(OrientRecords); err == nil || !strings.Contains(err.Error(), `duplicated header "a"`) {
+		t.Errorf("expected a duplicated header error, got %v", err)
+	}
+	if _, err := dup.ToJSON(OrientSplit); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := dup.ToJSON("index"); err == nil {
+		t.Errorf("expected an unknown orientation error")
+	}
+}
+
+func TestDf_UnmarshalJSON(t *testing.T) {
+	inferred := New([]series.Series[any]{
+		series.New([]any{"Ana", "Bob"}, "string"),
+		series.NewNullable([]any{31, nil}, "number"),
+		series.New([]any{1.5, 2.0}, "float"),
+		series.New([]any{true, false}, "bool"),
+		series.NewNullable([]any{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil}, "date"),
+	}, []string{"name", "age", "score", "active", "since"})
+	testCases := []struct {
+		name     string
+		orient   string
+		expected *Dataframe
+	}{
+		{"records", OrientRecords, inferred},
+		{"columns", OrientColumns, inferred},
+		{"split", OrientSplit, inferred},
+		{"table", OrientTable, jsonFixture()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, _ := jsonFixture().ToJSON(tc.orient)
+			var got Dataframe
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if report := Diff(&got, tc.expected, EqualOptions{}); report != "" {
+				t.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestDf_UnmarshalJSONInference(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected *Dataframe
+		wantErr  string
+	}{
+		{
+			name:  "records with missing keys and nested values",
+			value: `[{"a": 1, "b": {"x": 1}}, {"c": "2024-01-02", "a": 2.5}]`,
+			expected: New([]series.Series[any]{
+				series.New([]any{1.0, 2.5}, "float"),
+				series.NewNullable([]any{`{"x": 1}`, nil}, "string"),
+				series.NewNullable([]any{nil, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}, "date"),
+			}, []string{"a", "b", "c"}),
+		},
+		{
+			name:     "mixed values become strings",
+			value:    `{"m": [1, "2024-01-02", true]}`,
+			expected: makeDF([][]any{{"1", "2024-01-02", "true"}}, []string{"string"}, []string{"m"}),
+		},
+		{
+			name:     "headers named like split keys",
+			value:    `{"columns":[1,2],"data":["x","y"]}`,
+			expected: makeDF([][]any{{1, 2}, {"x", "y"}}, []string{"number", "string"}, []string{"columns", "data"}),
+		},
+		{
+			name:     "headers named like table keys",
+			value:    `{"schema":["a"],"data":[1.5]}`,
+			expected: makeDF([][]any{{"a"}, {1.5}}, []string{"string", "float"}, []string{"schema", "data"}),
+		},
+		{
+			name:     "non finite floats",
+			value:    `{"x":[1,"NaN","-Infinity"]}`,
+			expected: makeDF([][]any{{1.0, math.NaN(), math.Inf(-1)}}, []string{"float"}, []string{"x"}),
+		},
+		{name: "table type mismatch", value: `{"schema":{"fields":[{"name":"a","type":"number"}]},"data":[{"a":"x"}]}`, wantErr: `column "a": row 0`},
+		{name: "table unknown column", value: `{"schema":{"fields":[]},"data":[{"a":1}]}`, wantErr: `column "a" not in schema`},
+		{name: "columns of different lengths", value: `{"a":[1],"b":[1,2]}`, wantErr: "expected 1 values, got 2"},
+		{name: "not an object", value: `12`, wantErr: "expected an object or an array"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Dataframe
+			err := json.Unmarshal([]byte(tc.value), &got)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if report := Diff(&got, tc.expected, EqualOptions{}); report != "" {
+				t.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestDf_MarshalJSON(t *testing.T) {
+	payload := map[string]any{"frame": jsonFixture()}
+	b, err := json.Marshal(payload)
+	if err != nil || !strings.HasPrefix(string(b), `{"frame":{"schema":`) {
+		t.Fatalf("got %s (%v)", b, err)
+	}
+	var decoded struct {
+		Frame *Dataframe `json:"frame"`
+	}
+	if err := json.Unmarshal(b, &decoded); err != nil || !decoded.Frame.Equals(jsonFixture(), EqualOptions{}) {
+		t.Errorf("round trip failed: %v", err)
+	}
+}
+
+func TestDf_FromJSON(t *testing.T) {
+	value := []byte(`{"columns":["a","b"],"data":[[1],[2]]}`)
+	var detected Dataframe
+	if err := json.Unmarshal(value, &detected); err == nil || !strings.Contains(err.Error(), "expected 2 values, got 1") {
+		t.Fatalf("expected the split orientation to be detected, got %v", err)
+	}
+	got, err := FromJSON(value, OrientColumns)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := makeDF([][]any{{"a", "b"}, {"[1]", "[2]"}}, []string{"string", "string"}, []string{"columns", "data"})
+	if report := Diff(got, expected, EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+	for _, orient := range []string{OrientRecords, OrientColumns, OrientSplit, OrientTable} {
+		b, _ := jsonFixture().ToJSON(orient)
+		if _, err := FromJSON(b, orient); err != nil {
+			t.Errorf("%s: unexpected error %v", orient, err)
+		}
+	}
+	if _, err := FromJSON(value, "index"); err == nil {
+		t.Errorf("expected an unknown orientation error")
+	}
+}
@@ -0,0 +1,37 @@
+package df
+
+import (
+	"context"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// ComputeParallel is the concurrent counterpart of Compute: fn is called for every row
+// index with at most limit goroutines (limit <= 0 means runtime.GOMAXPROCS(0)) and the
+// results are stored in row order in a Series of type t. fn must only read the Dataframe.
+// The first error cancels the remaining rows and is returned as an *fn.IndexError
+// holding the row index.
+// Examples:
+//
+//	out, err := df.ComputeParallel(ctx, "number", 8, func(_ context.Context, d *df.Dataframe, i int) (any, error) {
+//		raw, _ := d.GetSeriesByHeader("raw")
+//		return strconv.Atoi(raw.GetValue(i).(string))
+//	})
+func (df *Dataframe) ComputeParallel(ctx context.Context, t string, limit int, fn func(ctx context.Context, d *Dataframe, idx int) (any, error)) (series.Series[any], error) {
+	indices := make([]int, df.rows())
+	for i := range indices {
+		indices[i] = i
+	}
+	out, err := fnVisual.ParallelMap(ctx, indices, limit, func(ctx context.Context, i int, _ int) (any, error) {
+		return fn(ctx, df, i)
+	})
+	if err != nil {
+		return series.Series[any]{}, err
+	}
+	s := series.New(make([]any, len(out)), t)
+	for i, value := range out {
+		s = s.SetValue(i, value)
+	}
+	return s, nil
+}
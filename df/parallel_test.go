@@ -0,0 +1,56 @@
+package df
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestDf_ComputeParallel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		raw           []any
+		expected      []any
+		expectedIndex int
+	}{
+		{"parse every row", []any{"1", "22", "333"}, []any{1, 22, 333}, -1},
+		{"error holds the row", []any{"1", "x", "3"}, nil, 1},
+		{"no rows", []any{}, []any{}, -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := makeDF([][]any{tc.raw}, []string{"string"}, []string{"raw"})
+			got, err := df.ComputeParallel(context.Background(), "number", 2, func(_ context.Context, d *Dataframe, i int) (any, error) {
+				raw, _ := d.GetSeriesByHeader("raw")
+				return strconv.Atoi(raw.GetValue(i).(string))
+			})
+			if tc.expectedIndex >= 0 {
+				var indexErr *fnVisual.IndexError
+				if !errors.As(err, &indexErr) || indexErr.Index != tc.expectedIndex {
+					t.Fatalf("expected an IndexError at %d, got %v", tc.expectedIndex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if got.Type() != "number" || len(got.ToSlice()) != len(tc.expected) || !is.SameSlice(got.ToSlice(), tc.expected) {
+				t.Errorf("got %v %v, expected number %v", got.Type(), got.ToSlice(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestDf_ComputeParallel_noColumns(t *testing.T) {
+	got, err := New(nil, []string{}).ComputeParallel(context.Background(), "number", 2, func(context.Context, *Dataframe, int) (any, error) {
+		return nil, errors.New("no row expected")
+	})
+	if err != nil || got.Len() != 0 {
+		t.Errorf("expected an empty Series, got %v (%v)", got.ToSlice(), err)
+	}
+}
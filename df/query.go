@@ -0,0 +1,346 @@
+package df
+
+import (
+	"fmt"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// exprNode is a node of a parsed expression (see parseExpr).
+type exprNode interface {
+	// check type-checks the node against the columns of df, binds column references
+	// and returns the type tag of the node ("null" for the null literal).
+	check(df *Dataframe) (string, error)
+	// eval returns the value of a checked node for the given row; nil is null.
+	eval(df *Dataframe, row int) any
+}
+
+type literalNode struct {
+	value any
+	t     string
+}
+
+type columnNode struct {
+	name string
+	pos  int
+	idx  int
+}
+
+type unaryNode struct {
+	op      string
+	operand exprNode
+	pos     int
+	t       string
+}
+
+type binaryNode struct {
+	op          string
+	left, right exprNode
+	pos         int
+	t           string // result type
+	operandType string // common type of the operands, used to compare and compute them
+}
+
+type inNode struct {
+	operand     exprNode
+	list        []exprNode
+	negate      bool
+	pos         int
+	operandType string
+}
+
+// Query keeps the rows for which the boolean expression is true; rows where it is null are dropped.
+// Expressions refer to columns by header (use backquotes for headers with spaces, e.g. `unit price`)
+// and support literals (12, 1.5, 'text', "text", true, false, null), arithmetic (+ - * / %),
+// comparisons (== != < <= > >=), membership (in, not in) and boolean logic (&& || !).
+// Boolean logic counts null as false: !null is true, null && x is false and null || x is x.
+// The expression is type-checked against the column type tags before being evaluated.
+// Examples:
+//
+//	err := df.Query("age >= 18 && country in ('FR', 'BE')")
+func (df *Dataframe) Query(expr string) error {
+	mask, err := df.Eval(expr)
+	if err != nil {
+		return err
+	}
+	if mask.Type() != "bool" {
+		return fmt.Errorf("expression %q: expected a bool result, got %s", expr, mask.Type())
+	}
+	df.keepRows(fnVisual.Map(mask.ToSlice(), func(v any, _ int) bool { return v == true }))
+	return nil
+}
+
+// WithColumn evaluates an expression for every row (see Query for the syntax) and stores
+// the result in the column name. An existing column with the same header is replaced,
+// otherwise the column is appended.
+// Examples:
+//
+//	err := df.WithColumn("total", "price * qty")
+func (df *Dataframe) WithColumn(name string, expr string) error {
+	s, err := df.Eval(expr)
+	if err != nil {
+		return err
+	}
+	idx := fnVisual.IndexOf(name, df.headers)
+	if idx < 0 {
+		df.Append(s, name)
+		return nil
+	}
+	newSheet := make([]series.Series[any], len(df.sheet))
+	copy(newSheet, df.sheet)
+	newSheet[idx] = s
+	df.sheet = newSheet
+	return nil
+}
+
+// Eval evaluates an expression for every row (see Query for the syntax) and returns the
+// resulting Series. Its type tag is the type of the expression.
+// Examples:
+//
+//	total, err := df.Eval("price * qty") // Series of type float if price is a float column
+func (df *Dataframe) Eval(expr string) (series.Series[any], error) {
+	node, err := parseExpr(expr)
+	if err != nil {
+		return series.Series[any]{}, fmt.Errorf("expression %q: %w", expr, err)
+	}
+	t, err := node.check(df)
+	if err != nil {
+		return series.Series[any]{}, fmt.Errorf("expression %q: %w", expr, err)
+	}
+	if t == "null" {
+		return series.Series[any]{}, fmt.Errorf("expression %q: cannot infer a type from null", expr)
+	}
+	values := make([]any, df.rows())
+	for i := range values {
+		values[i] = node.eval(df, i)
+	}
+	return series.NewNullable(values, t), nil
+}
+
+func (n *literalNode) check(_ *Dataframe) (string, error) {
+	return n.t, nil
+}
+
+func (n *literalNode) eval(_ *Dataframe, _ int) any {
+	return n.value
+}
+
+func (n *columnNode) check(df *Dataframe) (string, error) {
+	n.idx = fnVisual.IndexOf(n.name, df.headers)
+	if n.idx < 0 {
+		return "", fmt.Errorf("at offset %d: unknown column %q", n.pos, n.name)
+	}
+	return df.sheet[n.idx].Type(), nil
+}
+
+func (n *columnNode) eval(df *Dataframe, row int) any {
+	return df.sheet[n.idx].GetValue(row)
+}
+
+func (n *unaryNode) check(df *Dataframe) (string, error) {
+	t, err := n.operand.check(df)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case n.op == "!" && (t == "bool" || t == "null"):
+		n.t = "bool"
+	case n.op == "-" && (series.IsNumericType(t) || t == "null"):
+		n.t = t
+	default:
+		return "", fmt.Errorf("at offset %d: operator %s not defined on %s", n.pos, n.op, t)
+	}
+	return n.t, nil
+}
+
+func (n *unaryNode) eval(df *Dataframe, row int) any {
+	v := n.operand.eval(df, row)
+	if n.op == "!" {
+		// null counts as false, as in && and ||
+		return v != true
+	}
+	if v == nil {
+		return nil
+	}
+	return arithmetic("-", 0, v, n.t)
+}
+
+func (n *binaryNode) check(df *Dataframe) (string, error) {
+	lt, err := n.left.check(df)
+	if err != nil {
+		return "", err
+	}
+	rt, err := n.right.check(df)
+	if err != nil {
+		return "", err
+	}
+	mismatch := fmt.Errorf("at offset %d: operator %s not defined on %s and %s", n.pos, n.op, lt, rt)
+	switch n.op {
+	case "&&", "||":
+		if !(lt == "bool" || lt == "null") || !(rt == "bool" || rt == "null") {
+			return "", mismatch
+		}
+		n.t = "bool"
+	case "+", "-", "*", "/", "%":
+		t, ok := arithmeticType(lt, rt)
+		if !ok || (t == "string" && n.op != "+") {
+			return "", mismatch
+		}
+		n.operandType = t
+		n.t = t
+		if n.op == "/" {
+			n.t = "float"
+		}
+	default:
+		t, err := comparisonType(n.left, n.right, lt, rt)
+		if err != nil {
+			return "", fmt.Errorf("at offset %d: %w", n.pos, err)
+		}
+		if t == "bool" && n.op != "==" && n.op != "!=" {
+			return "", mismatch
+		}
+		n.operandType = t
+		n.t = "bool"
+	}
+	return n.t, nil
+}
+
+func (n *binaryNode) eval(df *Dataframe, row int) any {
+	switch n.op {
+	case "&&":
+		return n.left.eval(df, row) == true && n.right.eval(df, row) == true
+	case "||":
+		return n.left.eval(df, row) == true || n.right.eval(df, row) == true
+	}
+	l, r := n.left.eval(df, row), n.right.eval(df, row)
+	switch n.op {
+	case "+", "-", "*", "/", "%":
+		if l == nil || r == nil {
+			return nil
+		}
+		return arithmetic(n.op, l, r, n.operandType)
+	}
+	if l == nil || r == nil {
+		// null only equals null
+		switch n.op {
+		case "==":
+			return l == nil && r == nil
+		case "!=":
+			return (l == nil) != (r == nil)
+		}
+		return false
+	}
+	c, ok := series.CompareValues(l, r, n.operandType)
+	if !ok {
+		return n.op == "!="
+	}
+	switch n.op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+func (n *inNode) check(df *Dataframe) (string, error) {
+	t, err := n.operand.check(df)
+	if err != nil {
+		return "", err
+	}
+	n.operandType = t
+	for _, item := range n.list {
+		it, err := item.check(df)
+		if err != nil {
+			return "", err
+		}
+		common, err := comparisonType(n.operand, item, n.operandType, it)
+		if err != nil {
+			return "", fmt.Errorf("at offset %d: %w", n.pos, err)
+		}
+		if common != "null" {
+			n.operandType = common
+		}
+	}
+	return "bool", nil
+}
+
+func (n *inNode) eval(df *Dataframe, row int) any {
+	v := n.operand.eval(df, row)
+	found := false
+	for _, item := range n.list {
+		iv := item.eval(df, row)
+		if v == nil || iv == nil {
+			found = v == nil && iv == nil
+		} else if c, ok := series.CompareValues(v, iv, n.operandType); ok && c == 0 {
+			found = true
+		}
+		if found {
+			break
+		}
+	}
+	return found != n.negate
+}
+
+// arithmeticType returns the type used to compute an arithmetic operation between
+// operands of type lt and rt: the promoted numeric type, or "string" for concatenation.
+func arithmeticType(lt string, rt string) (string, bool) {
+	if lt == "null" {
+		lt = rt
+	}
+	if rt == "null" {
+		rt = lt
+	}
+	if lt == "string" && rt == "string" {
+		return "string", true
+	}
+	if lt == "null" || !series.IsNumericType(lt) || !series.IsNumericType(rt) {
+		return "", false
+	}
+	t, err := promoteType(lt, rt)
+	return t, err == nil
+}
+
+// comparisonType returns the type used to compare operands of type lt and rt.
+// Numbers compare with floats, dates compare with dates and with string literals
+// (which must then be valid dates), and null compares with anything.
+func comparisonType(left exprNode, right exprNode, lt string, rt string) (string, error) {
+	t, ok := series.CompareType(lt, rt)
+	switch {
+	case !ok:
+		return "", fmt.Errorf("cannot compare %s with %s", lt, rt)
+	case lt == "date" && rt == "string":
+		return t, checkDateLiteral(right)
+	case lt == "string" && rt == "date":
+		return t, checkDateLiteral(left)
+	}
+	return t, nil
+}
+
+// checkDateLiteral verifies that a string literal compared with a date is a valid date.
+func checkDateLiteral(node exprNode) error {
+	lit, ok := node.(*literalNode)
+	if !ok {
+		return nil
+	}
+	_, err := series.ParseDate(lit.value)
+	return err
+}
+
+// arithmetic applies op to two non-null operands computed as type t
+// ("number", "float" or "string"). "/" always computes with floats, so a division by
+// zero gives ±Inf or NaN; an integer modulo by zero returns null.
+func arithmetic(op string, a any, b any, t string) any {
+	if t == "string" {
+		return fmt.Sprint(a) + fmt.Sprint(b)
+	}
+	return series.Arithmetic(op, a, b, t)
+}
@@ -0,0 +1,133 @@
+package df
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func queryDF() *Dataframe {
+	return New([]series.Series[any]{
+		series.New([]any{"Ana", "Bob", "Cyd", "Dan"}, "string"),
+		series.NewNullable([]any{16, 21, nil, 40}, "number"),
+		series.New([]any{"FR", "BE", "FR", "DE"}, "string"),
+		series.New([]any{2.5, 1.0, 4.0, 3.0}, "float"),
+		series.New([]any{"2024-01-05", "2024-02-10", "2024-03-15", "2024-04-20"}, "date"),
+	}, []string{"name", "age", "country", "unit price", "date"})
+}
+
+func TestDf_Query(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expr     string
+		expected []any
+		wantErr  bool
+	}{
+		{"comparison and membership", "age >= 18 && country in ('FR','BE')", []any{"Bob"}, false},
+		{"not in", "country not in (\"FR\")", []any{"Bob", "Dan"}, false},
+		{"null check", "age == null || !(age > 18)", []any{"Ana", "Cyd"}, false},
+		{"arithmetic with quoted header", "`unit price` * 2 > age / 4", []any{"Ana"}, false},
+		{"date literal", "date >= '2024-03-01'", []any{"Cyd", "Dan"}, false},
+		{"unknown column", "height > 2", nil, true},
+		{"type mismatch", "age > 'x'", nil, true},
+		{"invalid date literal", "date > 'soon'", nil, true},
+		{"not a bool", "age + 1", nil, true},
+		{"syntax error", "age >= (18", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := queryDF()
+			err := df.Query(tc.expr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			names, _ := df.GetSeriesByHeader("name")
+			if !sliceEqualAny(names.ToSlice(), tc.expected) {
+				t.Fatalf("rows mismatch: got %v, expected %v", names.ToSlice(), tc.expected)
+			}
+			if names.Type() != "string" {
+				t.Fatalf("type tag lost: got %q", names.Type())
+			}
+		})
+	}
+}
+
+func TestDf_QueryNullLogic(t *testing.T) {
+	df := New([]series.Series[any]{
+		series.NewNullable([]any{true, true, nil, nil, false}, "bool"),
+		series.NewNullable([]any{true, nil, nil, false, nil}, "bool"),
+	}, []string{"a", "b"})
+	testCases := []struct {
+		expr     string
+		expected []any
+	}{
+		{"!a", []any{false, false, true, true, true}},
+		{"!null", []any{true, true, true, true, true}},
+		{"a && b", []any{true, false, false, false, false}},
+		{"a || b", []any{true, true, false, false, false}},
+		{"!(a && b)", []any{false, true, true, true, true}},
+		{"!a || !b", []any{false, true, true, true, true}},
+		{"!(a || b)", []any{false, false, true, true, true}},
+		{"!a && !b", []any{false, false, true, true, true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(tt *testing.T) {
+			got, err := df.Eval(tc.expr)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if got.Type() != "bool" || !sliceEqualAny(got.ToSlice(), tc.expected) {
+				tt.Errorf("expected %v, got %v %v", tc.expected, got.Type(), got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestDf_QueryLargeInts(t *testing.T) {
+	df := makeDF([][]any{{1 << 60, 1<<60 + 1}}, []string{"number"}, []string{"id"})
+	if err := df.Query("id == 1152921504606846977"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if ids := getCol(df, 0); !sliceEqualAny(ids, []any{1<<60 + 1}) {
+		t.Errorf("expected the second id only, got %v", ids)
+	}
+}
+
+func TestDf_WithColumn(t *testing.T) {
+	testCases := []struct {
+		name         string
+		header       string
+		expr         string
+		expectedType string
+		expected     []any
+	}{
+		{"number arithmetic", "next", "age + 1", "number", []any{17, 22, nil, 41}},
+		{"float promotion", "total", "`unit price` * age", "float", []any{40.0, 21.0, nil, 120.0}},
+		{"string concat", "label", "name + '-' + country", "string", []any{"Ana-FR", "Bob-BE", "Cyd-FR", "Dan-DE"}},
+		{"replace column", "age", "-age % 7", "number", []any{-2, 0, nil, -5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := queryDF()
+			if err := df.WithColumn(tc.header, tc.expr); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			col, _ := df.GetSeriesByHeader(tc.header)
+			if col.Type() != tc.expectedType {
+				t.Fatalf("type mismatch: got %v, expected %v", col.Type(), tc.expectedType)
+			}
+			if !sliceEqualAny(col.ToSlice(), tc.expected) {
+				t.Fatalf("values mismatch: got %v, expected %v", col.ToSlice(), tc.expected)
+			}
+			if tc.header == "age" && !is.SameSlice(df.GetHeaders(), queryDF().GetHeaders()) {
+				t.Fatalf("headers changed: got %v", df.GetHeaders())
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package df
+
+import (
+	"fmt"
+	"time"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// AggFunc is an aggregation of PivotTable.
+type AggFunc struct {
+	// Fn aggregates the non-null values of a group into a single value. values may be
+	// empty when every value of the group is null.
+	Fn func(values []any) any
+	// KeepType makes the generated columns keep the type of the values column, for
+	// aggregations returning one of the values of the group.
+	KeepType bool
+}
+
+// Built-in aggregations.
+var (
+	// AggSum sums numbers; it returns an int when every value is an integer, and null when
+	// a value is not a number.
+	AggSum = AggFunc{Fn: aggSum}
+	// AggMean returns the arithmetic mean as a float64, or null for an empty group or when
+	// a value is not a number.
+	AggMean = AggFunc{Fn: aggMean}
+	// AggCount returns the number of non-null values.
+	AggCount = AggFunc{Fn: func(values []any) any { return len(values) }}
+	// AggMin returns the smallest value, or null for an empty group.
+	AggMin = AggFunc{Fn: func(values []any) any { return aggExtreme(values, -1) }, KeepType: true}
+	// AggMax returns the largest value, or null for an empty group.
+	AggMax = AggFunc{Fn: func(values []any) any { return aggExtreme(values, 1) }, KeepType: true}
+	// AggFirst returns the first value, or null for an empty group.
+	AggFirst = AggFunc{Fn: func(values []any) any {
+		if len(values) == 0 {
+			return nil
+		}
+		return values[0]
+	}, KeepType: true}
+	// AggLast returns the last value, or null for an empty group.
+	AggLast = AggFunc{Fn: func(values []any) any {
+		if len(values) == 0 {
+			return nil
+		}
+		return values[len(values)-1]
+	}, KeepType: true}
+)
+
+// Melt reshapes the Dataframe from wide to long format.
+// Each row is unpivoted into one row per value column: idVars are repeated, the varName
+// column holds the header of the value column and the valueName column holds its value.
+// valueVars defaults to every column not in idVars, varName to "variable" and valueName
+// to "value". Value columns of mixed types are promoted ("number" and "float" give
+// "float") or, when incompatible, converted to "string".
+// Examples:
+//
+//	// | city | jan | feb |      | city | month | sales |
+//	// | Lyon | 10  | 12  |  ->  | Lyon | jan   | 10    |
+//	//                           | Lyon | feb   | 12    |
+//	long, err := wide.Melt([]string{"city"}, []string{"jan", "feb"}, "month", "sales")
+func (df *Dataframe) Melt(idVars []string, valueVars []string, varName string, valueName string) (*Dataframe, error) {
+	if varName == "" {
+		varName = "variable"
+	}
+	if valueName == "" {
+		valueName = "value"
+	}
+	if len(valueVars) == 0 {
+		valueVars = fnVisual.Filter(df.headers, func(h string) bool { return !is.In(h, idVars) })
+	}
+	for _, header := range append(append([]string{}, idVars...), valueVars...) {
+		if !is.In(header, df.headers) {
+			return nil, fmt.Errorf("column %q not found", header)
+		}
+	}
+	if is.In(varName, idVars) || is.In(valueName, idVars) || varName == valueName {
+		return nil, fmt.Errorf("melt: headers %q and %q must differ from each other and from id columns", varName, valueName)
+	}
+	if len(valueVars) == 0 {
+		return nil, fmt.Errorf("melt: no value column, every column is an id column")
+	}
+
+	valueType := ""
+	for _, header := range valueVars {
+		col, _ := df.GetSeriesByHeader(header)
+		if valueType == "" {
+			valueType = col.Type()
+			continue
+		}
+		promoted, err := promoteType(valueType, col.Type())
+		if err != nil {
+			promoted = "string"
+		}
+		valueType = promoted
+	}
+
+	out := New(nil, []string{})
+	for _, header := range idVars {
+		col, _ := df.GetSeriesByHeader(header)
+		var values []any
+		for range valueVars {
+			values = append(values, col.ToSlice()...)
+		}
+		out.Append(series.NewNullable(values, col.Type()), header)
+	}
+	var variables, values []any
+	for _, header := range valueVars {
+		col, _ := df.GetSeriesByHeader(header)
+		for _, v := range col.ToSlice() {
+			if col.Type() != valueType {
+				if converted, err := coerceValue(v, valueType); err == nil {
+					v = converted
+				}
+			}
+			variables = append(variables, header)
+			values = append(values, v)
+		}
+	}
+	out.Append(series.New(variables, "string"), varName)
+	out.Append(series.NewNullable(values, valueType), valueName)
+	return out, nil
+}
+
+// Pivot reshapes the Dataframe from long to wide format.
+// The distinct values of index become rows, the distinct values of columns become
+// headers (formatted as strings, in order of first appearance) and cells hold the matching
+// value of the values column. Missing combinations are null and rows with a null in
+// columns are ignored. It returns an error when an
+// (index, columns) pair appears more than once; use PivotTable to aggregate such duplicates.
+// Examples:
+//
+//	// | city | month | sales |      | city | jan | feb |
+//	// | Lyon | jan   | 10    |  ->  | Lyon | 10  | 12  |
+//	// | Lyon | feb   | 12    |
+//	wide, err := long.Pivot("city", "month", "sales")
+func (df *Dataframe) Pivot(index string, columns string, values string) (*Dataframe, error) {
+	duplicated := false
+	first := AggFunc{Fn: func(group []any) any {
+		if len(group) > 1 {
+			duplicated = true
+		}
+		return AggFirst.Fn(group)
+	}, KeepType: true}
+	out, err := df.PivotTable(index, columns, values, first, nil)
+	if err != nil {
+		return nil, err
+	}
+	if duplicated {
+		return nil, fmt.Errorf("pivot: duplicated entries for index %q and columns %q, use PivotTable", index, columns)
+	}
+	return out, nil
+}
+
+// PivotTable reshapes the Dataframe from long to wide format like Pivot, aggregating
+// the values sharing the same (index, columns) pair with agg. Combinations without any
+// row are set to fill (nil for null). The generated columns keep the type of the values
+// column when agg.KeepType is set (AggFirst, AggLast, AggMin and AggMax), unless a cell
+// does not convert to it; otherwise their type is inferred from the aggregated values.
+// Examples:
+//
+//	wide, err := long.PivotTable("city", "month", "sales", df.AggSum, 0)
+//	// a custom aggregation returning one of the values keeps their type
+//	middle := df.AggFunc{Fn: func(v []any) any {
+//		if len(v) == 0 {
+//			return nil
+//		}
+//		return v[len(v)/2]
+//	}, KeepType: true}
+func (df *Dataframe) PivotTable(index string, columns string, values string, agg AggFunc, fill any) (*Dataframe, error) {
+	for _, header := range []string{index, columns, values} {
+		if !is.In(header, df.headers) {
+			return nil, fmt.Errorf("column %q not found", header)
+		}
+	}
+	indexCol, _ := df.GetSeriesByHeader(index)
+	pivotCol, _ := df.GetSeriesByHeader(columns)
+	valueCol, _ := df.GetSeriesByHeader(values)
+	rowCodes, rowValues := indexCol.Factorize()
+	colCodes, colValues := pivotCol.Factorize()
+
+	groups := make([][][]any, rowValues.Len())
+	seen := make([][]bool, rowValues.Len())
+	for r := range groups {
+		groups[r] = make([][]any, colValues.Len())
+		seen[r] = make([]bool, colValues.Len())
+	}
+	for i := 0; i < df.rows(); i++ {
+		r, c := rowCodes.GetValue(i), colCodes.GetValue(i)
+		seen[r][c] = true
+		if v := valueCol.GetValue(i); v != nil {
+			groups[r][c] = append(groups[r][c], v)
+		}
+	}
+
+	out := New(nil, []string{})
+	out.Append(rowValues, index)
+	for c, header := range colValues.ToSlice() {
+		if header == nil {
+			continue
+		}
+		name, err := coerceValue(header, "string")
+		if err != nil {
+			return nil, err
+		}
+		if is.In(name.(string), out.headers) {
+			return nil, fmt.Errorf("pivot: duplicated header %q", name)
+		}
+		cells := make([]any, rowValues.Len())
+		for r := range cells {
+			if !seen[r][c] {
+				cells[r] = fill
+				continue
+			}
+			cells[r] = agg.Fn(groups[r][c])
+		}
+		if agg.KeepType {
+			if converted, ok := coerceCells(cells, valueCol.Type()); ok {
+				out.Append(series.NewNullable(converted, valueCol.Type()), name.(string))
+				continue
+			}
+		}
+		t := inferType(cells)
+		converted, _ := coerceCells(cells, t)
+		out.Append(series.NewNullable(converted, t), name.(string))
+	}
+	return out, nil
+}
+
+// coerceCells converts the cells to the type tag t. Cells that do not convert are kept
+// as they are and reported by a false result.
+func coerceCells(cells []any, t string) ([]any, bool) {
+	out := make([]any, len(cells))
+	ok := true
+	for r, v := range cells {
+		converted, err := coerceValue(v, t)
+		if err != nil {
+			out[r], ok = v, false
+			continue
+		}
+		out[r] = converted
+	}
+	return out, ok
+}
+
+// inferType returns the type tag able to hold every non-null value: "number" for
+// integers, "float" when a float is present, "bool", "date" for time.Time and "string"
+// for strings or mixed values. An all-null slice is "string".
+func inferType(values []any) string {
+	t := ""
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		vt := "string"
+		if _, ok := v.(time.Time); ok {
+			vt = "date"
+		} else if _, ok := v.(bool); ok {
+			vt = "bool"
+		} else if _, ok := series.ToInt(v); ok && !isFloatValue(v) {
+			vt = "number"
+		} else if _, ok := series.ToFloat(v); ok {
+			vt = "float"
+		}
+		if t == "" {
+			t = vt
+			continue
+		}
+		promoted, err := promoteType(t, vt)
+		if err != nil {
+			return "string"
+		}
+		t = promoted
+	}
+	if t == "" {
+		return "string"
+	}
+	return t
+}
+
+// isFloatValue reports whether v is a float32 or float64.
+func isFloatValue(v any) bool {
+	switch v.(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
+
+func aggSum(values []any) any {
+	total, totalInt, allInt := 0.0, 0, true
+	for _, v := range values {
+		if i, ok := series.ToInt(v); ok && !isFloatValue(v) {
+			totalInt += i
+			total += float64(i)
+			continue
+		}
+		f, ok := series.ToFloat(v)
+		if !ok {
+			return nil
+		}
+		allInt = false
+		total += f
+	}
+	if allInt {
+		return totalInt
+	}
+	return total
+}
+
+func aggMean(values []any) any {
+	if len(values) == 0 {
+		return nil
+	}
+	total := 0.0
+	for _, v := range values {
+		f, ok := series.ToFloat(v)
+		if !ok {
+			return nil
+		}
+		total += f
+	}
+	return total / float64(len(values))
+}
+
+// aggExtreme returns the smallest (sign -1) or largest (sign 1) value.
+func aggExtreme(values []any, sign int) any {
+	if len(values) == 0 {
+		return nil
+	}
+	t := inferType(values)
+	out := values[0]
+	for _, v := range values[1:] {
+		if c, ok := series.CompareValues(v, out, t); ok && c*sign > 0 {
+			out = v
+		}
+	}
+	return out
+}
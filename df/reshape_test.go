@@ -0,0 +1,167 @@
+package df
+
+import (
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestDf_Melt(t *testing.T) {
+	wide := makeDF([][]any{{"Lyon", "Nice"}, {10, 20}, {1.5, 2.5}}, []string{"string", "number", "float"}, []string{"city", "jan", "feb"})
+	got, err := wide.Melt([]string{"city"}, nil, "month", "sales")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !is.SameSlice(got.GetHeaders(), []string{"city", "month", "sales"}) {
+		t.Fatalf("headers mismatch: got %v", got.GetHeaders())
+	}
+	expectedCols := [][]any{
+		{"Lyon", "Nice", "Lyon", "Nice"},
+		{"jan", "jan", "feb", "feb"},
+		{10.0, 20.0, 1.5, 2.5},
+	}
+	for i := range expectedCols {
+		if !sliceEqualAny(getCol(got, i), expectedCols[i]) {
+			t.Fatalf("col %d mismatch: got %v, expected %v", i, getCol(got, i), expectedCols[i])
+		}
+	}
+	if sales, _ := got.GetSeries(2); sales.Type() != "float" {
+		t.Fatalf("expected float values, got %v", sales.Type())
+	}
+	if _, err := wide.Melt([]string{"missing"}, nil, "", ""); err == nil {
+		t.Fatalf("expected an error for an unknown id column")
+	}
+	if _, err := wide.Melt([]string{"city", "jan", "feb"}, nil, "", ""); err == nil {
+		t.Fatalf("expected an error without value column")
+	}
+}
+
+func TestDf_Pivot(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	long := makeDF(
+		[][]any{{"Lyon", "Lyon", "Nice", "Lyon"}, {"jan", "feb", "jan", "jan"}, {10, 12, 20, 5}},
+		[]string{"string", "string", "number"},
+		[]string{"city", "month", "sales"},
+	)
+	testCases := []struct {
+		name            string
+		pivot           func() (*Dataframe, error)
+		expectedHeaders []string
+		expectedTypes   []string
+		expectedCols    [][]any
+		wantErr         bool
+	}{
+		{
+			name:    "pivot with duplicates",
+			pivot:   func() (*Dataframe, error) { return long.Pivot("city", "month", "sales") },
+			wantErr: true,
+		},
+		{
+			name:            "sum with fill",
+			pivot:           func() (*Dataframe, error) { return long.PivotTable("city", "month", "sales", AggSum, 0) },
+			expectedHeaders: []string{"city", "jan", "feb"},
+			expectedTypes:   []string{"string", "number", "number"},
+			expectedCols:    [][]any{{"Lyon", "Nice"}, {15, 20}, {12, 0}},
+		},
+		{
+			name:            "mean without fill",
+			pivot:           func() (*Dataframe, error) { return long.PivotTable("city", "month", "sales", AggMean, nil) },
+			expectedHeaders: []string{"city", "jan", "feb"},
+			expectedTypes:   []string{"string", "float", "float"},
+			expectedCols:    [][]any{{"Lyon", "Nice"}, {7.5, 20.0}, {12.0, nil}},
+		},
+		{
+			name:            "max",
+			pivot:           func() (*Dataframe, error) { return long.PivotTable("month", "city", "sales", AggMax, nil) },
+			expectedHeaders: []string{"month", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "number", "number"},
+			expectedCols:    [][]any{{"jan", "feb"}, {10, 12}, {20, nil}},
+		},
+	}
+
+	events := New([]series.Series[any]{
+		series.New([]any{"Lyon", "Lyon", "Nice"}, "string"),
+		series.New([]any{"start", "end", "start"}, "string"),
+		series.NewNullable([]any{"2024-03-01", nil, "2024-03-04"}, "date"),
+	}, []string{"city", "step", "day"})
+	testCases = append(testCases, []struct {
+		name            string
+		pivot           func() (*Dataframe, error)
+		expectedHeaders []string
+		expectedTypes   []string
+		expectedCols    [][]any
+		wantErr         bool
+	}{
+		{
+			name:            "pivot keeps the values type",
+			pivot:           func() (*Dataframe, error) { return events.Pivot("city", "step", "day") },
+			expectedHeaders: []string{"city", "start", "end"},
+			expectedTypes:   []string{"string", "date", "date"},
+			expectedCols:    [][]any{{"Lyon", "Nice"}, {day(1), day(4)}, {nil, nil}},
+		},
+		{
+			name:            "min keeps the values type",
+			pivot:           func() (*Dataframe, error) { return events.PivotTable("step", "city", "day", AggMin, nil) },
+			expectedHeaders: []string{"step", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "date", "date"},
+			expectedCols:    [][]any{{"start", "end"}, {day(1), nil}, {day(4), nil}},
+		},
+		{
+			name: "custom aggregation keeps the values type",
+			pivot: func() (*Dataframe, error) {
+				latest := AggFunc{Fn: func(v []any) any { return AggMax.Fn(v) }, KeepType: true}
+				return events.PivotTable("step", "city", "day", latest, nil)
+			},
+			expectedHeaders: []string{"step", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "date", "date"},
+			expectedCols:    [][]any{{"start", "end"}, {day(1), nil}, {day(4), nil}},
+		},
+		{
+			name:            "sum of non numeric values is null",
+			pivot:           func() (*Dataframe, error) { return events.PivotTable("step", "city", "day", AggSum, nil) },
+			expectedHeaders: []string{"step", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "number", "string"},
+			expectedCols:    [][]any{{"start", "end"}, {nil, 0}, {nil, nil}},
+		},
+		{
+			name:            "mean of non numeric values is null",
+			pivot:           func() (*Dataframe, error) { return events.PivotTable("step", "city", "day", AggMean, nil) },
+			expectedHeaders: []string{"step", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "string", "string"},
+			expectedCols:    [][]any{{"start", "end"}, {nil, nil}, {nil, nil}},
+		},
+		{
+			name:            "count infers its type",
+			pivot:           func() (*Dataframe, error) { return events.PivotTable("step", "city", "day", AggCount, 0) },
+			expectedHeaders: []string{"step", "Lyon", "Nice"},
+			expectedTypes:   []string{"string", "number", "number"},
+			expectedCols:    [][]any{{"start", "end"}, {1, 0}, {1, 0}},
+		},
+	}...)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.pivot()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !is.SameSlice(got.GetHeaders(), tc.expectedHeaders) {
+				t.Fatalf("headers mismatch: got %v, expected %v", got.GetHeaders(), tc.expectedHeaders)
+			}
+			for i := range tc.expectedCols {
+				col, _ := got.GetSeries(i)
+				if col.Type() != tc.expectedTypes[i] {
+					t.Fatalf("col %d type mismatch: got %v, expected %v", i, col.Type(), tc.expectedTypes[i])
+				}
+				if !sliceEqualAny(col.ToSlice(), tc.expectedCols[i]) {
+					t.Fatalf("col %d mismatch: got %v, expected %v", i, col.ToSlice(), tc.expectedCols[i])
+				}
+			}
+		})
+	}
+}
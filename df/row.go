@@ -0,0 +1,234 @@
+package df
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// ErrNullValue is returned by the typed getters of Row when the requested cell is null.
+var ErrNullValue = errors.New("null value")
+
+// Row is a read-only view over a single row of a Dataframe.
+// It does not copy data: reading a Row reflects the current content of its Dataframe.
+type Row struct {
+	df  *Dataframe
+	idx int
+}
+
+// Row returns a view over the row at the given zero-based index.
+// It panics if idx is out of range.
+// Examples:
+//
+//	row := df.Row(0)
+//	name, err := row.String("name")
+func (df *Dataframe) Row(idx int) Row {
+	if idx < 0 || idx >= df.rows() {
+		panic(fmt.Sprintf("row index %d out of range [0, %d)", idx, df.rows()))
+	}
+	return Row{df, idx}
+}
+
+// Rows returns an iterator over the rows of the Dataframe and their index.
+// Examples:
+//
+//	for i, row := range df.Rows() {
+//		age, _ := row.Int("age")
+//		fmt.Println(i, age)
+//	}
+func (df *Dataframe) Rows() iter.Seq2[int, Row] {
+	return func(yield func(int, Row) bool) {
+		for i := 0; i < df.rows(); i++ {
+			if !yield(i, Row{df, i}) {
+				return
+			}
+		}
+	}
+}
+
+// Index returns the zero-based index of the row in its Dataframe.
+func (r Row) Index() int {
+	return r.idx
+}
+
+// Values returns the values of the row in column order.
+func (r Row) Values() []any {
+	out := make([]any, len(r.df.sheet))
+	for c, col := range r.df.sheet {
+		out[c] = col.GetValue(r.idx)
+	}
+	return out
+}
+
+// Map returns the values of the row keyed by header.
+func (r Row) Map() map[string]any {
+	out := make(map[string]any, len(r.df.sheet))
+	for c, col := range r.df.sheet {
+		out[r.df.headers[c]] = col.GetValue(r.idx)
+	}
+	return out
+}
+
+// Get returns the raw value of the given column.
+// It returns an error if the header does not exist.
+func (r Row) Get(name string) (any, error) {
+	idx := fnVisual.IndexOf(name, r.df.headers)
+	if idx < 0 {
+		return nil, fmt.Errorf("column %q not found", name)
+	}
+	return r.df.sheet[idx].GetValue(r.idx), nil
+}
+
+// Int returns the value of the given column as an int.
+// Integers, integral floats and numeric strings are accepted.
+// Examples:
+//
+//	age, err := row.Int("age")
+func (r Row) Int(name string) (int, error) {
+	v, err := r.value(name)
+	if err != nil {
+		return 0, err
+	}
+	if s, ok := v.(string); ok {
+		i, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return 0, fmt.Errorf("column %q: %w", name, err)
+		}
+		return i, nil
+	}
+	i, ok := series.ToInt(v)
+	if !ok {
+		return 0, fmt.Errorf("column %q: cannot use %v (%T) as int", name, v, v)
+	}
+	return i, nil
+}
+
+// Float returns the value of the given column as a float64.
+// Numbers and numeric strings are accepted.
+// Examples:
+//
+//	price, err := row.Float("price")
+func (r Row) Float(name string) (float64, error) {
+	v, err := r.value(name)
+	if err != nil {
+		return 0, err
+	}
+	if s, ok := v.(string); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return 0, fmt.Errorf("column %q: %w", name, err)
+		}
+		return f, nil
+	}
+	f, ok := series.ToFloat(v)
+	if !ok {
+		return 0, fmt.Errorf("column %q: cannot use %v (%T) as float", name, v, v)
+	}
+	return f, nil
+}
+
+// String returns the value of the given column as a string.
+// Non string values are formatted with fmt.Sprint.
+// Examples:
+//
+//	name, err := row.String("name")
+func (r Row) String(name string) (string, error) {
+	v, err := r.value(name)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// Bool returns the value of the given column as a bool.
+// Booleans and strings accepted by strconv.ParseBool are accepted.
+// Examples:
+//
+//	active, err := row.Bool("active")
+func (r Row) Bool(name string) (bool, error) {
+	v, err := r.value(name)
+	if err != nil {
+		return false, err
+	}
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case string:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(b))
+		if err != nil {
+			return false, fmt.Errorf("column %q: %w", name, err)
+		}
+		return parsed, nil
+	}
+	return false, fmt.Errorf("column %q: cannot use %v (%T) as bool", name, v, v)
+}
+
+// Time returns the value of the given column as a time.Time (see series.ParseDate).
+// Examples:
+//
+//	date, err := row.Time("date")
+func (r Row) Time(name string) (time.Time, error) {
+	v, err := r.value(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tm, err := series.ParseDate(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("column %q: %w", name, err)
+	}
+	return tm, nil
+}
+
+// value returns the non-null value of the given column.
+func (r Row) value(name string) (any, error) {
+	v, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, fmt.Errorf("column %q: %w", name, ErrNullValue)
+	}
+	return v, nil
+}
+
+// AppendRow appends a single row given in column order.
+// Values are coerced to each column type with the same rules as series.NewNullable
+// (e.g. "12" becomes 12 in a "number" column) and nil values are kept as nulls.
+// Examples:
+//
+//	err := df.AppendRow([]any{"Carl", "42"})
+func (df *Dataframe) AppendRow(values []any) error {
+	return df.AppendRows([][]any{values})
+}
+
+// AppendRows appends several rows given in column order (see AppendRow).
+// Nothing is appended if any row does not match the number of columns.
+// Examples:
+//
+//	err := df.AppendRows([][]any{{"Carl", 42}, {"Dina", 37}})
+func (df *Dataframe) AppendRows(rows [][]any) error {
+	for i, row := range rows {
+		if len(row) != len(df.sheet) {
+			return fmt.Errorf("row %d has %d values, expected %d", i, len(row), len(df.sheet))
+		}
+	}
+	newSheet := make([]series.Series[any], 0, len(df.sheet))
+	for c, col := range df.sheet {
+		values := make([]any, len(rows))
+		for r, row := range rows {
+			values[r] = row[c]
+		}
+		newSheet = append(newSheet, col.Append(series.NewNullable(values, col.Type()).ToSlice()))
+	}
+	df.sheet = newSheet
+	return nil
+}
@@ -0,0 +1,110 @@
+package df
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestDf_Row(t *testing.T) {
+	df := New([]series.Series[any]{
+		series.New([]any{"Ana", "Bob"}, "string"),
+		series.NewNullable([]any{23, nil}, "number"),
+		series.New([]any{"2024-03-01", "2024-03-02"}, "date"),
+	}, []string{"name", "age", "date"})
+
+	row := df.Row(0)
+	if name, err := row.String("name"); err != nil || name != "Ana" {
+		t.Fatalf("String: got %v (%v), expected Ana", name, err)
+	}
+	if age, err := row.Int("age"); err != nil || age != 23 {
+		t.Fatalf("Int: got %v (%v), expected 23", age, err)
+	}
+	if age, err := row.Float("age"); err != nil || age != 23.0 {
+		t.Fatalf("Float: got %v (%v), expected 23", age, err)
+	}
+	if date, err := row.Time("date"); err != nil || !date.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Time: got %v (%v), expected 2024-03-01", date, err)
+	}
+	if _, err := row.Bool("name"); err == nil {
+		t.Fatalf("Bool: expected an error for a string column")
+	}
+	if _, err := row.Get("missing"); err == nil {
+		t.Fatalf("Get: expected an error for an unknown column")
+	}
+	if _, err := df.Row(1).Int("age"); !errors.Is(err, ErrNullValue) {
+		t.Fatalf("Int: expected ErrNullValue, got %v", err)
+	}
+	if got := row.Map(); got["name"] != "Ana" || got["age"] != 23 {
+		t.Fatalf("Map: got %v", got)
+	}
+
+	big := New([]series.Series[any]{series.NewNullable([]any{9007199254740993, uint64(math.MaxUint64), 1e30}, "number")}, []string{"n"})
+	if n, err := big.Row(0).Int("n"); err != nil || n != 9007199254740993 {
+		t.Fatalf("Int: got %v (%v), expected 9007199254740993", n, err)
+	}
+	for r := 1; r < 3; r++ {
+		if n, err := big.Row(r).Int("n"); err == nil {
+			t.Fatalf("Int: expected an out of range error on row %d, got %v", r, n)
+		}
+	}
+}
+
+func TestDf_Rows(t *testing.T) {
+	df := makeDF([][]any{{1, 2, 3}, {"a", "b", "c"}}, []string{"number", "string"}, []string{"n", "s"})
+	var got []any
+	for i, row := range df.Rows() {
+		if i == 2 {
+			break
+		}
+		v, _ := row.Get("s")
+		got = append(got, v)
+	}
+	if !sliceEqualAny(got, []any{"a", "b"}) {
+		t.Fatalf("Rows: got %v, expected [a b]", got)
+	}
+}
+
+func TestDf_AppendRows(t *testing.T) {
+	testCases := []struct {
+		name         string
+		rows         [][]any
+		expectedCols [][]any
+		wantErr      bool
+	}{
+		{
+			name:         "coerce strings",
+			rows:         [][]any{{"3", "c"}, {4, "d"}},
+			expectedCols: [][]any{{1, 2, 3, 4}, {"a", "b", "c", "d"}},
+		},
+		{
+			name:         "keep nulls",
+			rows:         [][]any{{nil, "c"}, {"4", nil}},
+			expectedCols: [][]any{{1, 2, nil, 4}, {"a", "b", "c", nil}},
+		},
+		{
+			name:         "wrong width",
+			rows:         [][]any{{3, "c"}, {4}},
+			expectedCols: [][]any{{1, 2}, {"a", "b"}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := makeDF([][]any{{1, 2}, {"a", "b"}}, []string{"number", "string"}, []string{"n", "s"})
+			err := df.AppendRows(tc.rows)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			for i := range tc.expectedCols {
+				if !sliceEqualAny(getCol(df, i), tc.expectedCols[i]) {
+					t.Fatalf("col %d mismatch: got %v, expected %v", i, getCol(df, i), tc.expectedCols[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package df
+
+import (
+	"fmt"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// SplitColumn splits the values of a "string" column around sep and appends one new
+// "string" column per header. The last new column holds the unsplit remainder and
+// missing parts are null. The source column is kept.
+// Examples:
+//
+//	// "Ana Smith" -> first: "Ana", last: "Smith"
+//	err := df.SplitColumn("full_name", " ", []string{"first", "last"})
+func (df *Dataframe) SplitColumn(header string, sep string, headers []string) error {
+	idx := fnVisual.IndexOf(header, df.headers)
+	if idx < 0 {
+		return fmt.Errorf("column %q not found", header)
+	}
+	if df.sheet[idx].Type() != "string" {
+		return fmt.Errorf("column %q is of type %s, expected string", header, df.sheet[idx].Type())
+	}
+	if len(headers) == 0 {
+		return fmt.Errorf("no header given to split column %q", header)
+	}
+	for _, h := range headers {
+		if is.In(h, df.headers) {
+			return fmt.Errorf("column %q already exists", h)
+		}
+	}
+	parts := df.sheet[idx].Str().Split(sep, len(headers))
+	for i, part := range parts {
+		df.Append(part, headers[i])
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package df
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestDf_SplitColumn(t *testing.T) {
+	testCases := []struct {
+		name            string
+		header          string
+		headers         []string
+		expectedHeaders []string
+		expectedCols    [][]any
+		wantErr         bool
+	}{
+		{
+			name:            "split in two",
+			header:          "full",
+			headers:         []string{"first", "last"},
+			expectedHeaders: []string{"full", "n", "first", "last"},
+			expectedCols:    [][]any{{"Ana Smith", "Bob"}, {1, 2}, {"Ana", "Bob"}, {"Smith", nil}},
+		},
+		{name: "not a string column", header: "n", headers: []string{"a"}, wantErr: true},
+		{name: "existing header", header: "full", headers: []string{"n"}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := makeDF([][]any{{"Ana Smith", "Bob"}, {1, 2}}, []string{"string", "number"}, []string{"full", "n"})
+			err := df.SplitColumn(tc.header, " ", tc.headers)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !is.SameSlice(df.GetHeaders(), tc.expectedHeaders) {
+				t.Fatalf("headers mismatch: got %v, expected %v", df.GetHeaders(), tc.expectedHeaders)
+			}
+			for i := range tc.expectedCols {
+				if !sliceEqualAny(getCol(df, i), tc.expectedCols[i]) {
+					t.Fatalf("col %d mismatch: got %v, expected %v", i, getCol(df, i), tc.expectedCols[i])
+				}
+			}
+		})
+	}
+}
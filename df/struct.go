@@ -0,0 +1,236 @@
+package df
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+var (
+	// ErrUnmappedColumn is returned by ToStructs when a column has no matching struct field.
+	ErrUnmappedColumn = errors.New("unmapped column")
+	// ErrMissingColumn is returned by ToStructs when a tagged struct field has no matching column.
+	ErrMissingColumn = errors.New("missing column")
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structField describes a struct field mapped to a column through its `df` tag.
+type structField struct {
+	index  int
+	header string
+	t      string
+}
+
+// FromStructs builds a Dataframe from a slice of structs (or pointers to structs).
+// Only exported fields tagged `df:"name,type"` become columns, in field order. The type
+// part is optional and defaults to the type matching the field kind: "number" for integers,
+// "float" for floats, "bool", "string" and "date" for time.Time. Nil pointer fields
+// become nulls.
+// Examples:
+//
+//	type Person struct {
+//		Name string    `df:"name"`
+//		Age  int       `df:"age,number"`
+//		Born time.Time `df:"born,date"`
+//	}
+//	frame, err := df.FromStructs([]Person{{"Ana", 23, born}})
+func FromStructs[T any](items []T) (*Dataframe, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields, err := structFields(typ)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([][]any, len(fields))
+	for i := range columns {
+		columns[i] = make([]any, len(items))
+	}
+	for r, item := range items {
+		rv := reflect.ValueOf(item)
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return nil, fmt.Errorf("item %d is nil", r)
+			}
+			rv = rv.Elem()
+		}
+		for c, field := range fields {
+			fv := rv.Field(field.index)
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			v, err := coerceValue(fv.Interface(), field.t)
+			if err != nil {
+				return nil, fmt.Errorf("item %d, column %q: %w", r, field.header, err)
+			}
+			columns[c][r] = v
+		}
+	}
+	out := New(nil, []string{})
+	for c, field := range fields {
+		out.Append(series.NewNullable(columns[c], field.t), field.header)
+	}
+	return out, nil
+}
+
+// ToStructs decodes every row of the Dataframe into a struct of type T.
+// Columns are matched to fields through the `df:"name,type"` tag (see FromStructs) and
+// values are first converted to the type tag of their column. A column without matching
+// field returns ErrUnmappedColumn and a tagged field without column returns ErrMissingColumn.
+// Null values leave the field to its zero value; use pointer fields to tell them apart.
+// Examples:
+//
+//	people, err := df.ToStructs[Person](frame)
+func ToStructs[T any](df *Dataframe) ([]T, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields, err := structFields(typ)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range df.headers {
+		if !fnVisual.Any(fields, func(f structField) bool { return f.header == header }) {
+			return nil, fmt.Errorf("column %q of %s: %w", header, typ, ErrUnmappedColumn)
+		}
+	}
+	columns := make([]int, len(fields))
+	for i, field := range fields {
+		columns[i] = fnVisual.IndexOf(field.header, df.headers)
+		if columns[i] < 0 {
+			return nil, fmt.Errorf("column %q of %s: %w", field.header, typ, ErrMissingColumn)
+		}
+	}
+
+	out := make([]T, df.rows())
+	for r := range out {
+		rv := reflect.ValueOf(&out[r]).Elem()
+		for i, field := range fields {
+			col := df.sheet[columns[i]]
+			v, err := coerceValue(col.GetValue(r), col.Type())
+			if err == nil {
+				err = assignValue(rv.Field(field.index), v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("row %d, column %q: %w", r, field.header, err)
+			}
+		}
+	}
+	return out, nil
+}
+
+// structFields lists the tagged fields of a struct type.
+func structFields(typ reflect.Type) ([]structField, error) {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", typ)
+	}
+	var fields []structField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag, ok := f.Tag.Lookup("df")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("field %s.%s is tagged but not exported", typ, f.Name)
+		}
+		header, t, _ := strings.Cut(tag, ",")
+		if header == "" {
+			header = f.Name
+		}
+		if t == "" {
+			t = typeForKind(f.Type)
+		}
+		if !is.In(t, []string{"string", "number", "date", "float", "bool"}) {
+			return nil, fmt.Errorf("field %s.%s: type %q not supported", typ, f.Name, t)
+		}
+		if fnVisual.Any(fields, func(sf structField) bool { return sf.header == header }) {
+			return nil, fmt.Errorf("field %s.%s: duplicated column %q", typ, f.Name, header)
+		}
+		fields = append(fields, structField{i, header, t})
+	}
+	return fields, nil
+}
+
+// typeForKind returns the type tag matching a Go type.
+func typeForKind(typ reflect.Type) string {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ == timeType {
+		return "date"
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "number"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Bool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// assignValue stores a value produced by coerceValue into a struct field.
+func assignValue(field reflect.Value, v any) error {
+	if v == nil {
+		return nil
+	}
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := assignValue(ptr.Elem(), v); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+	if field.Type() == timeType {
+		tm, err := series.ParseDate(v)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(tm))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		s, err := coerceValue(v, "string")
+		if err != nil {
+			return err
+		}
+		field.SetString(s.(string))
+		return nil
+	case reflect.Bool:
+		if b, ok := v.(bool); ok {
+			field.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, ok := v.(int); ok && !field.OverflowInt(int64(i)) {
+			field.SetInt(int64(i))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if i, ok := v.(int); ok && i >= 0 && !field.OverflowUint(uint64(i)) {
+			field.SetUint(uint64(i))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := series.ToFloat(v); ok {
+			field.SetFloat(f)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot assign %v (%T) to a field of type %s", v, v, field.Type())
+}
@@ -0,0 +1,118 @@
+package df
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+type person struct {
+	Name    string    `df:"name"`
+	Age     int       `df:"age,number"`
+	Score   *float64  `df:"score"`
+	Born    time.Time `df:"born,date"`
+	Ignored string
+}
+
+func TestDf_FromStructs(t *testing.T) {
+	score := 12.5
+	born := time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC)
+	got, err := FromStructs([]person{
+		{"Ana", 23, &score, born, "x"},
+		{"Bob", 31, nil, born, "y"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !is.SameSlice(got.GetHeaders(), []string{"name", "age", "score", "born"}) {
+		t.Fatalf("headers mismatch: got %v", got.GetHeaders())
+	}
+	expectedTypes := []string{"string", "number", "float", "date"}
+	expectedCols := [][]any{{"Ana", "Bob"}, {23, 31}, {12.5, nil}, {born, born}}
+	for i := range expectedCols {
+		col, _ := got.GetSeries(i)
+		if col.Type() != expectedTypes[i] {
+			t.Fatalf("col %d type mismatch: got %v, expected %v", i, col.Type(), expectedTypes[i])
+		}
+		if !sliceEqualAny(col.ToSlice(), expectedCols[i]) {
+			t.Fatalf("col %d mismatch: got %v, expected %v", i, col.ToSlice(), expectedCols[i])
+		}
+	}
+}
+
+func TestDf_FromStructs_overflow(t *testing.T) {
+	type counter struct {
+		N uint64 `df:"n,number"`
+	}
+	_, err := FromStructs([]counter{{1}, {math.MaxUint64}})
+	if err == nil || !strings.Contains(err.Error(), `item 1, column "n"`) {
+		t.Errorf("expected an overflow error on item 1, got %v", err)
+	}
+
+	frame := New([]series.Series[any]{series.NewNullable([]any{7, 1e30}, "number")}, []string{"n"})
+	_, err = ToStructs[counter](frame)
+	if err == nil || !strings.Contains(err.Error(), `row 1, column "n"`) {
+		t.Errorf("expected an overflow error on row 1, got %v", err)
+	}
+}
+
+func TestDf_ToStructs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		df       *Dataframe
+		expected []person
+		wantErr  error
+	}{
+		{
+			name: "decode with conversions",
+			df: New([]series.Series[any]{
+				series.New([]any{"Ana"}, "string"),
+				series.New([]any{"23"}, "number"),
+				series.NewNullable([]any{nil}, "float"),
+				series.New([]any{"2001-02-03"}, "date"),
+			}, []string{"name", "age", "score", "born"}),
+			expected: []person{{"Ana", 23, nil, time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC), ""}},
+		},
+		{
+			name: "unmapped column",
+			df: makeDF([][]any{{"Ana"}, {23}, {1.0}, {"2001-02-03"}, {true}},
+				[]string{"string", "number", "float", "date", "bool"},
+				[]string{"name", "age", "score", "born", "extra"}),
+			wantErr: ErrUnmappedColumn,
+		},
+		{
+			name:    "missing column",
+			df:      makeDF([][]any{{"Ana"}, {23}}, []string{"string", "number"}, []string{"name", "age"}),
+			wantErr: ErrMissingColumn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ToStructs[person](tc.df)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tc.expected) {
+				t.Fatalf("length mismatch: got %d, expected %d", len(got), len(tc.expected))
+			}
+			for i := range got {
+				g, e := got[i], tc.expected[i]
+				if g.Name != e.Name || g.Age != e.Age || (g.Score == nil) != (e.Score == nil) || !g.Born.Equal(e.Born) {
+					t.Fatalf("row %d mismatch: got %+v, expected %+v", i, g, e)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package df
+
+import (
+	"fmt"
+	"strconv"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// ValueCounts returns a Dataframe with the distinct non-null values of a Series and their
+// frequency, sorted by decreasing count. Columns are "value" (same type as the Series),
+// "count" (number) and "share" (float, count divided by the number of non-null values).
+// Examples:
+//
+//	s := series.New([]string{"FR", "BE", "FR"}, "string")
+//	df.ValueCounts(s).Debug()
+//	// | value(string) | count(number) | share(float)       |
+//	// | FR            | 2             | 0.6666666666666666 |
+//	// | BE            | 1             | 0.3333333333333333 |
+func ValueCounts[T any](s series.Series[T]) *Dataframe {
+	values, counts := s.ValueCounts()
+	total := s.Len() - s.NullCount()
+	shares := make([]any, counts.Len())
+	for i, count := range counts.ToSlice() {
+		shares[i] = float64(count) / float64(total)
+	}
+	return New([]series.Series[any]{
+		series.New(fnVisual.Map(values.ToSlice(), func(v T, _ int) any { return v }), s.Type()),
+		series.New(fnVisual.Map(counts.ToSlice(), func(c int, _ int) any { return c }), "number"),
+		series.New(shares, "float"),
+	}, []string{"value", "count", "share"})
+}
+
+// Duplicated returns a bool Series marking rows whose values in the subset columns
+// (all columns when subset is empty) repeat an other row. keep has the same meaning
+// as in series.Series.Duplicated.
+// Examples:
+//
+//	mask, err := df.Duplicated(series.KeepFirst, "email")
+func (df *Dataframe) Duplicated(keep string, subset ...string) (series.Series[bool], error) {
+	if len(subset) == 0 {
+		subset = df.headers
+	}
+	keys := make([]string, df.rows())
+	for _, header := range subset {
+		idx := fnVisual.IndexOf(header, df.headers)
+		if idx < 0 {
+			return series.Series[bool]{}, fmt.Errorf("column %q not found", header)
+		}
+		codes, _ := df.sheet[idx].Factorize()
+		for r, code := range codes.ToSlice() {
+			keys[r] += strconv.Itoa(code) + ","
+		}
+	}
+	return series.New(keys, "string").Duplicated(keep), nil
+}
+
+// DropDuplicates removes rows repeating the values of a previous row in the subset
+// columns (all columns when subset is empty). The first occurrence is kept.
+// Examples:
+//
+//	err := df.DropDuplicates("email")
+func (df *Dataframe) DropDuplicates(subset ...string) error {
+	mask, err := df.Duplicated(series.KeepFirst, subset...)
+	if err != nil {
+		return err
+	}
+	df.keepRows(series.Not(mask).ToSlice())
+	return nil
+}
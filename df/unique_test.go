@@ -0,0 +1,60 @@
+package df
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestDf_ValueCounts(t *testing.T) {
+	got := ValueCounts(series.New([]string{"FR", "BE", "FR", "FR"}, "string"))
+	if !is.SameSlice(got.GetHeaders(), []string{"value", "count", "share"}) {
+		t.Fatalf("headers mismatch: got %v", got.GetHeaders())
+	}
+	expectedCols := [][]any{{"FR", "BE"}, {3, 1}, {0.75, 0.25}}
+	for i := range expectedCols {
+		if !sliceEqualAny(getCol(got, i), expectedCols[i]) {
+			t.Fatalf("col %d mismatch: got %v, expected %v", i, getCol(got, i), expectedCols[i])
+		}
+	}
+}
+
+func TestDf_DropDuplicates(t *testing.T) {
+	testCases := []struct {
+		name         string
+		subset       []string
+		expectedCols [][]any
+		wantErr      bool
+	}{
+		{
+			name:         "all columns",
+			expectedCols: [][]any{{"a", "a", "b"}, {1, 2, 1}},
+		},
+		{
+			name:         "subset",
+			subset:       []string{"k"},
+			expectedCols: [][]any{{"a", "b"}, {1, 1}},
+		},
+		{
+			name:    "unknown column",
+			subset:  []string{"missing"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			df := makeDF([][]any{{"a", "a", "b", "a"}, {1, 2, 1, 1}}, []string{"string", "number"}, []string{"k", "v"})
+			err := df.DropDuplicates(tc.subset...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			for i := range tc.expectedCols {
+				if !sliceEqualAny(getCol(df, i), tc.expectedCols[i]) {
+					t.Fatalf("col %d mismatch: got %v, expected %v", i, getCol(df, i), tc.expectedCols[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package df
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// rows returns the number of rows of the Dataframe, or 0 when it has no columns.
+func (df *Dataframe) rows() int {
+	if len(df.sheet) == 0 {
+		return 0
+	}
+	return df.sheet[0].Len()
+}
+
+// keepRows keeps the rows where keep is true. Unlike ApplyFromBoolStatement, the columns
+// keep their type tag.
+func (df *Dataframe) keepRows(keep []bool) {
+	newSheet := make([]series.Series[any], 0, len(df.sheet))
+	for _, col := range df.sheet {
+		var values []any
+		for r, v := range col.ToSlice() {
+			if keep[r] {
+				values = append(values, v)
+			}
+		}
+		newSheet = append(newSheet, col.Range(0, 0).Append(values))
+	}
+	df.sheet = newSheet
+}
+
+// promoteType returns the type tag able to hold values of both tags a and b.
+// Identical tags are kept, "number" and "float" promote to "float", anything else is an error.
+func promoteType(a string, b string) (string, error) {
+	if a == b {
+		return a, nil
+	}
+	if (a == "number" && b == "float") || (a == "float" && b == "number") {
+		return "float", nil
+	}
+	return "", fmt.Errorf("incompatible types %q and %q", a, b)
+}
+
+// convertValues converts the values of a column tagged from to the representation of the tag to.
+// Only the "number" to "float" promotion changes values; nulls are kept.
+func convertValues(values []any, from string, to string) []any {
+	if from == to || to != "float" {
+		return values
+	}
+	out := make([]any, len(values))
+	for i, v := range values {
+		if f, ok := series.ToFloat(v); ok {
+			out[i] = f
+			continue
+		}
+		out[i] = v
+	}
+	return out
+}
+
+// coerceValue converts v to the canonical representation of the type tag t:
+// int for "number", float64 for "float", bool for "bool", string for "string" and
+// time.Time for "date". Nil is kept as null.
+func coerceValue(v any, t string) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch t {
+	case "number":
+		if s, ok := v.(string); ok {
+			return strconv.Atoi(strings.TrimSpace(s))
+		}
+		i, ok := series.ToInt(v)
+		if !ok {
+			return nil, fmt.Errorf("cannot use %v (%T) as number", v, v)
+		}
+		return i, nil
+	case "float":
+		if s, ok := v.(string); ok {
+			return strconv.ParseFloat(strings.TrimSpace(s), 64)
+		}
+		f, ok := series.ToFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("cannot use %v (%T) as float", v, v)
+		}
+		return f, nil
+	case "bool":
+		if s, ok := v.(string); ok {
+			return strconv.ParseBool(strings.TrimSpace(s))
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("cannot use %v (%T) as bool", v, v)
+		}
+		return b, nil
+	case "date":
+		return series.ParseDate(v)
+	default:
+		if tm, ok := v.(time.Time); ok {
+			return tm.Format(time.RFC3339Nano), nil
+		}
+		return fmt.Sprint(v), nil
+	}
+}
@@ -8,7 +8,8 @@
 //	- df (dataframe): a minimal 2D table built from multiple series
 //
 //	Around these you get:
-//	- extract: helpers to load data (CSV/Excel) into series/dataframes
+//	- extract: helpers to load data (CSV/fixed-width/Excel/JSON/Parquet/Arrow/SQL) into series/dataframes
+//	- load: helpers to write dataframes out (Parquet/Arrow/SQL)
 //	- fn: functional helpers (Map, Filter, Reduce, Any/All, IndexOf, Reverse)
 //	- is: predicates and small utilities (In, Zero, Truthy/Falsy, SameSlice)
 //
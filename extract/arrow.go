@@ -0,0 +1,54 @@
+package extract
+
+import (
+	"io"
+	"os"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/internal/arrow"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// Arrow reads an Arrow IPC file, or a file holding an Arrow IPC stream, and returns a
+// dataframe (see ArrowReader for the type mapping).
+// Examples:
+//
+//	extract.Arrow("sales.arrow") // return dataframe, error
+func Arrow(path string) (*df.Dataframe, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ArrowReader(file)
+}
+
+// ArrowReader reads Arrow IPC data in the file or the stream format, detected from its
+// first bytes, and returns a dataframe concatenating its record batches. Null values of
+// the validity bitmaps become nulls. Integers give "number", floating points and decimals
+// give "float", Bool gives "bool", Date and Timestamp give "date" (in UTC) and strings and
+// binaries give "string"; dictionary encoded columns are decoded. A type tag stored in the
+// field metadata under the "go-starter.type" key (as written by load.ArrowWriter) is used
+// when it is compatible with the Arrow type. Nested types and compressed batches are not
+// supported. The data is not read incrementally: r is read entirely into memory (with
+// io.ReadAll) before decoding, even in the stream format.
+// Examples:
+//
+//	extract.ArrowReader(os.Stdin) // return dataframe, error
+func ArrowReader(r io.Reader) (*df.Dataframe, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := arrow.Read(data)
+	if err != nil {
+		return nil, err
+	}
+	sheet := make([]series.Series[any], len(columns))
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		sheet[i] = series.NewNullable(c.Values, c.Type)
+		headers[i] = c.Name
+	}
+	return df.New(sheet, headers), nil
+}
@@ -0,0 +1,43 @@
+package extract
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/load"
+)
+
+func TestExtract_Arrow(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts load.ArrowOptions
+	}{
+		{"file", load.ArrowOptions{}},
+		{"stream", load.ArrowOptions{Format: load.ArrowStream}},
+		{"file batches", load.ArrowOptions{BatchSize: 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			path := filepath.Join(tt.TempDir(), "data.arrow")
+			if err := load.Arrow(salesFixture(), path, tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			got, err := Arrow(path)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, salesFixture(), df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+	if _, err := Arrow(filepath.Join(t.TempDir(), "missing.arrow")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+	if _, err := ArrowReader(bytes.NewReader([]byte("id,country\n1,FR\n"))); err == nil || !strings.HasPrefix(err.Error(), "arrow: ") {
+		t.Errorf("expected an arrow error, got %v", err)
+	}
+}
@@ -1,3 +1,3 @@
 // Package extract provides data extractors for various formats and sources.
-// Subpackages/files include CSV and Excel readers that return generic series and dataframes.
+// Subpackages/files include CSV, Excel, JSON, NDJSON, fixed-width, Parquet, Arrow IPC and SQL readers that return generic series and dataframes.
 package extract
@@ -0,0 +1,253 @@
+package extract
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// FixedWidthColumn describes a column of a fixed-width file. Positions are counted in
+// characters (runes) from 0.
+type FixedWidthColumn struct {
+	Name  string // header; the header line text at this position, or columnN, when empty
+	Start int    // position of the first character
+	Width int    // number of characters
+	Type  string // type tag; inferred from the values when empty
+	Pad   string // padding characters of the column, trimmed from the values (FixedWidthOptions.Pad for numbers when empty)
+}
+
+// FixedWidthOptions configures FixedWidth and FixedWidthReader.
+type FixedWidthOptions struct {
+	Columns   []FixedWidthColumn // column specs; inferred from the whitespace alignment when empty
+	SkipLines int                // lines ignored at the start of the input (banners, titles)
+	Header    bool               // the first line after SkipLines holds the column names
+	Pad       string             // padding characters of the "number" and "float" columns ("0" for zero-padded amounts)
+}
+
+// FixedWidth reads a fixed-width text file and returns a dataframe (see FixedWidthReader).
+// Examples:
+//
+//	extract.FixedWidth("statement.txt", extract.FixedWidthOptions{Columns: []extract.FixedWidthColumn{
+//		{Name: "date", Start: 0, Width: 10, Type: "date"},
+//		{Name: "label", Start: 10, Width: 30},
+//		{Name: "amount", Start: 40, Width: 12, Type: "float"},
+//	}, Pad: "0"}) // return dataframe, error
+func FixedWidth(path string, opts FixedWidthOptions) (*df.Dataframe, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return FixedWidthReader(file, opts)
+}
+
+// FixedWidthReader reads fixed-width text and returns a dataframe.
+// Without column specs, columns are inferred from the alignment: a column boundary is a
+// position that holds a space on every line (header included), so columns must be
+// separated by at least one blank character. Values are trimmed of spaces, then of their
+// padding characters: "number" and "float" values, right-aligned, lose them on their left
+// ("000120.50" gives 120.5 with the Pad "0"), the other values on their right ("ANA***"
+// gives ANA with the column Pad "*"). Columns without a type get "number", "float", "bool"
+// or "date" when all their non-empty values parse as such, "string" otherwise. The
+// dataframe is built with df.ParseRaw: blank values are nulls and a value that does not
+// parse as the type of its column is an error reporting its line number in the input
+// (skipped, header and blank lines included). Blank lines are ignored.
+// Examples:
+//
+//	extract.FixedWidthReader(strings.NewReader("id  name   amount\n 1  Ana     12.50\n12  Bob    -3.00\n"),
+//		extract.FixedWidthOptions{Header: true})
+//	// | id(number) | name(string) | amount(float) |
+//	// | 1          | Ana          | 12.5          |
+//	// | 12         | Bob          | -3            |
+func FixedWidthReader(r io.Reader, opts FixedWidthOptions) (*df.Dataframe, error) {
+	for _, c := range opts.Columns {
+		if c.Start < 0 || c.Width <= 0 {
+			return nil, fmt.Errorf("fixed width: column %q: invalid position %d and width %d", c.Name, c.Start, c.Width)
+		}
+		if c.Type != "" && !slices.Contains([]string{"string", "number", "float", "bool", "date"}, c.Type) {
+			return nil, fmt.Errorf("fixed width: column %q: unknown type %q", c.Name, c.Type)
+		}
+	}
+
+	// lineNumbers holds the physical line number, from 1, of each kept line
+	var lines [][]rune
+	var lineNumbers []int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for n := 0; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if n < opts.SkipLines || strings.TrimSpace(line) == "" {
+			continue
+		}
+		lines = append(lines, []rune(line))
+		lineNumbers = append(lineNumbers, n+1)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("fixed width: %w", err)
+	}
+
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = inferFixedWidthColumns(lines)
+	}
+	var header []rune
+	if opts.Header && len(lines) > 0 {
+		header, lines, lineNumbers = lines[0], lines[1:], lineNumbers[1:]
+	}
+
+	// the raw data handed to df.ParseRaw: the headers, then one row per line
+	raw := make([][]string, len(lines)+1)
+	raw[0] = make([]string, len(columns))
+	for row := range lines {
+		raw[row+1] = make([]string, len(columns))
+	}
+	types := make([]string, len(columns))
+	for i, c := range columns {
+		name := c.Name
+		if name == "" {
+			name = strings.TrimSpace(sliceRunes(header, c.Start, c.Width))
+		}
+		if name == "" {
+			name = "column" + strconv.Itoa(i+1)
+		}
+		raw[0][i] = name
+		numberPad := c.Pad
+		if numberPad == "" {
+			numberPad = opts.Pad
+		}
+		values := make([]string, len(lines))
+		for row, line := range lines {
+			values[row] = strings.TrimSpace(sliceRunes(line, c.Start, c.Width))
+		}
+		t := c.Type
+		if t == "" {
+			t = inferRawColumn(values, numberPad)
+		}
+		pad := c.Pad
+		if t == "number" || t == "float" {
+			pad = numberPad
+		}
+		for row, v := range values {
+			if v != "" {
+				v = trimPadding(v, pad, t)
+			}
+			raw[row+1][i] = v
+		}
+		types[i] = t
+	}
+
+	out, err := df.ParseRaw(raw, types, 0, df.RawOptions{Strict: true})
+	var rawErr *df.RawError
+	if errors.As(err, &rawErr) {
+		return nil, fmt.Errorf("fixed width: line %d, column %q: cannot parse %q as %s",
+			lineNumbers[rawErr.Row-1], rawErr.Column, rawErr.Value, rawErr.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fixed width: %w", err)
+	}
+	return out, nil
+}
+
+// inferFixedWidthColumns splits lines into columns at the positions holding a space on
+// every line. The last column extends to the end of the longest line.
+func inferFixedWidthColumns(lines [][]rune) []FixedWidthColumn {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+	used := make([]bool, width)
+	for _, line := range lines {
+		for p, r := range line {
+			if r != ' ' && r != '\t' {
+				used[p] = true
+			}
+		}
+	}
+	var columns []FixedWidthColumn
+	for p := range width {
+		if used[p] && (p == 0 || !used[p-1]) {
+			if n := len(columns); n > 0 {
+				columns[n-1].Width = p - columns[n-1].Start
+			}
+			columns = append(columns, FixedWidthColumn{Start: p})
+		}
+	}
+	if n := len(columns); n > 0 {
+		columns[n-1].Width = width - columns[n-1].Start
+	}
+	return columns
+}
+
+// sliceRunes returns the characters of line in [start, start+width), or less when the line
+// is shorter.
+func sliceRunes(line []rune, start, width int) string {
+	if start >= len(line) {
+		return ""
+	}
+	return string(line[start:min(start+width, len(line))])
+}
+
+// trimPadding trims the padding characters of a value: on the left of right-aligned
+// "number" and "float" values, on the right of the other values.
+func trimPadding(v string, pad string, t string) string {
+	if pad == "" {
+		return v
+	}
+	if t != "number" && t != "float" {
+		return strings.TrimSpace(strings.TrimRight(v, pad))
+	}
+	trimmed := strings.TrimSpace(strings.TrimLeft(v, pad))
+	if trimmed != v && strings.Contains(pad, "0") && (trimmed == "" || trimmed[0] == '.') {
+		// the zeros were the whole value or its integer part
+		trimmed = "0" + trimmed
+	}
+	return trimmed
+}
+
+// inferRawColumn returns the type tag of a column from its non-empty values, whose number
+// padding characters are pad.
+func inferRawColumn(values []string, pad string) string {
+	var present []string
+	for _, v := range values {
+		if v != "" {
+			present = append(present, v)
+		}
+	}
+	if len(present) == 0 {
+		return "string"
+	}
+	for _, t := range []string{"number", "float", "bool", "date"} {
+		if !slices.ContainsFunc(present, func(v string) bool {
+			_, err := parseRawValue(trimPadding(v, pad, t), t)
+			return err != nil
+		}) {
+			return t
+		}
+	}
+	return "string"
+}
+
+// parseRawValue converts a trimmed, non-empty value to the canonical Go value of the
+// type tag t.
+func parseRawValue(v string, t string) (any, error) {
+	switch t {
+	case "number":
+		return strconv.Atoi(v)
+	case "float":
+		return strconv.ParseFloat(v, 64)
+	case "bool":
+		return strconv.ParseBool(v)
+	case "date":
+		return series.ParseDate(v)
+	default:
+		return v, nil
+	}
+}
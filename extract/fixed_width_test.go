@@ -0,0 +1,121 @@
+package extract
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestExtract_FixedWidthReader(t *testing.T) {
+	statement := "BANK STATEMENT 2024-03\n" +
+		"\n" +
+		"DATE      LABEL          AMOUNT   OK\n" +
+		"2024-03-01RENT**********-00950.00 true\n" +
+		"2024-03-02SALARY********002100.50false\n" +
+		"\r\n" +
+		"2024-03-05CAFÉ**********000000.00 true\r\n"
+	specs := []FixedWidthColumn{
+		{Name: "date", Start: 0, Width: 10, Type: "date"},
+		{Name: "label", Start: 10, Width: 14, Pad: "*"},
+		{Name: "amount", Start: 24, Width: 9, Type: "float"},
+		{Start: 33, Width: 5},
+	}
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	aligned := "id  name     amount\n" +
+		" 1  Ana       12.50\n" +
+		"12  Bob Lee   -3\n" +
+		"    Cyd      100.25\n"
+
+	testCases := []struct {
+		name     string
+		input    string
+		opts     FixedWidthOptions
+		expected *df.Dataframe
+	}{
+		{"column specs", statement, FixedWidthOptions{Columns: specs, SkipLines: 2, Header: true, Pad: "0"}, df.New([]series.Series[any]{
+			series.New([]any{day(1), day(2), day(5)}, "date"),
+			series.New([]any{"RENT", "SALARY", "CAFÉ"}, "string"),
+			series.New([]any{-950.0, 2100.5, 0.0}, "float"),
+			series.New([]any{true, false, true}, "bool"),
+		}, []string{"date", "label", "amount", "OK"})},
+		{"inferred columns", aligned, FixedWidthOptions{Header: true}, df.New([]series.Series[any]{
+			series.NewNullable([]any{1, 12, nil}, "number"),
+			series.New([]any{"Ana", "Bob Lee", "Cyd"}, "string"),
+			series.New([]any{12.5, -3.0, 100.25}, "float"),
+		}, []string{"id", "name", "amount"})},
+		{"inferred columns without header", "a  001\nbb 010\n", FixedWidthOptions{Pad: "0"}, df.New([]series.Series[any]{
+			series.New([]any{"a", "bb"}, "string"),
+			series.New([]any{1, 10}, "number"),
+		}, []string{"column1", "column2"})},
+		{"blank values", "code  rate   day\nEUR   1.00   2024-03-01\nUSD\n", FixedWidthOptions{Header: true}, df.New([]series.Series[any]{
+			series.New([]any{"EUR", "USD"}, "string"),
+			series.NewNullable([]any{1.0, nil}, "float"),
+			series.NewNullable([]any{day(1), nil}, "date"),
+		}, []string{"code", "rate", "day"})},
+		{"empty input", "", FixedWidthOptions{Header: true}, df.New([]series.Series[any]{}, []string{})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := FixedWidthReader(strings.NewReader(tc.input), tc.opts)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, tc.expected, df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestExtract_FixedWidth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	if err := os.WriteFile(path, []byte("code rate\nEUR  1.00\nUSD  1.09\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got, err := FixedWidth(path, FixedWidthOptions{Header: true})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := df.New([]series.Series[any]{
+		series.New([]any{"EUR", "USD"}, "string"),
+		series.New([]any{1.0, 1.09}, "float"),
+	}, []string{"code", "rate"})
+	if report := df.Diff(got, expected, df.EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+	statement := filepath.Join(t.TempDir(), "statement.txt")
+	if err := os.WriteFile(statement, []byte("BANK\n\ncode rate\nEUR  1.00\n\nUSD  1,09\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	testCases := []struct {
+		name    string
+		path    string
+		opts    FixedWidthOptions
+		wantErr string
+	}{
+		{"missing file", filepath.Join(t.TempDir(), "missing.txt"), FixedWidthOptions{}, "no such file"},
+		{"width", path, FixedWidthOptions{Columns: []FixedWidthColumn{{Name: "a", Width: 0}}}, `column "a": invalid position 0 and width 0`},
+		{"position", path, FixedWidthOptions{Columns: []FixedWidthColumn{{Name: "a", Start: -1, Width: 2}}}, `column "a": invalid position -1 and width 2`},
+		{"type", path, FixedWidthOptions{Columns: []FixedWidthColumn{{Name: "a", Width: 2, Type: "money"}}}, `column "a": unknown type "money"`},
+		{"invalid float", path, FixedWidthOptions{Header: true, Columns: []FixedWidthColumn{{Start: 0, Width: 4, Type: "float"}}},
+			`fixed width: line 2, column "code": cannot parse "EUR" as float`},
+		{"invalid date", path, FixedWidthOptions{Header: true, Columns: []FixedWidthColumn{{Start: 5, Width: 4, Type: "date"}}},
+			`fixed width: line 2, column "rate": cannot parse "1.00" as date`},
+		{"physical line", statement, FixedWidthOptions{SkipLines: 1, Header: true, Columns: []FixedWidthColumn{{Start: 5, Width: 4, Type: "float"}}},
+			`fixed width: line 6, column "rate": cannot parse "1,09" as float`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := FixedWidth(tc.path, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package extract
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// record is a flattened JSON object: dotted keys in order of appearance and their values
+// (nil, bool, json.Number, or string for strings and arrays).
+type record struct {
+	keys   []string
+	values map[string]any
+}
+
+// Json reads a file holding a JSON array of objects and returns a dataframe
+// (see JsonReader for the conversion rules).
+// Examples:
+//
+//	extract.Json("users.json") // return dataframe, error
+func Json(path string) (*df.Dataframe, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return JsonReader(file)
+}
+
+// JsonReader reads a JSON array of objects and returns a dataframe with a row per object.
+// Nested objects are flattened into dotted headers ({"a": {"b": 1}} gives the column
+// "a.b"), arrays are kept as their JSON text. The columns are the union of the keys in
+// order of first appearance; missing keys and JSON nulls become nulls. Each column type is
+// inferred from its non-null values: "bool", "number" (integers), "float" (numbers with a
+// fractional part or an exponent), "date" (strings that all parse with series.ParseDate) or
+// "string" (including columns mixing several JSON types). An empty nested object and a
+// key given twice in an object, directly or once flattened ("a.b" and {"a": {"b": 1}}),
+// are errors.
+// Examples:
+//
+//	extract.JsonReader(strings.NewReader(`[{"id": 1, "user": {"name": "Ana"}}, {"id": 2}]`))
+//	// | id(number) | user.name(string) |
+//	// | 1          | Ana               |
+//	// | 2          | <nil>             |
+func JsonReader(r io.Reader) (*df.Dataframe, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	if tok != json.Delim('[') {
+		return nil, fmt.Errorf("json: expected an array of objects, got %v", tok)
+	}
+	var records []record
+	for dec.More() {
+		rec, err := decodeRecord(dec)
+		if err != nil {
+			return nil, fmt.Errorf("json: record %d: %w", len(records), err)
+		}
+		records = append(records, rec)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	return recordsToDataframe(records), nil
+}
+
+// Ndjson reads a file of newline-delimited JSON objects and returns a dataframe
+// (see NdjsonReader).
+// Examples:
+//
+//	extract.Ndjson("events.ndjson") // return dataframe, error
+func Ndjson(path string) (*df.Dataframe, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NdjsonReader(file)
+}
+
+// NdjsonReader reads newline-delimited JSON, one object per line, and returns a dataframe
+// with a row per object. Blank lines are ignored and a line holding anything after its
+// object is an error; the conversion rules are those of JsonReader.
+// Examples:
+//
+//	extract.NdjsonReader(strings.NewReader("{\"id\": 1}\n{\"id\": 2.5}\n")) // a single "float" column id
+//	extract.NdjsonReader(strings.NewReader("{\"id\": 1}{\"id\": 2}\n"))     // nil, error
+func NdjsonReader(r io.Reader) (*df.Dataframe, error) {
+	reader := bufio.NewReader(r)
+	var records []record
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return nil, fmt.Errorf("ndjson: %w", readErr)
+		}
+		if len(bytes.TrimSpace(line)) > 0 {
+			dec := json.NewDecoder(bytes.NewReader(line))
+			dec.UseNumber()
+			rec, err := decodeRecord(dec)
+			if err == nil {
+				if _, err = dec.Token(); errors.Is(err, io.EOF) {
+					err = nil
+				} else {
+					err = errors.New("expected a single object on the line")
+				}
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ndjson: record %d: %w", len(records), err)
+			}
+			records = append(records, rec)
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	return recordsToDataframe(records), nil
+}
+
+// decodeRecord reads the next JSON object from dec and flattens it. It returns io.EOF
+// only when the input ends before the object starts, and io.ErrUnexpectedEOF when it
+// ends inside the object.
+func decodeRecord(dec *json.Decoder) (record, error) {
+	rec := record{values: map[string]any{}}
+	tok, err := dec.Token()
+	if err != nil {
+		return rec, err
+	}
+	if tok != json.Delim('{') {
+		return rec, fmt.Errorf("expected an object, got %v", tok)
+	}
+	if err := flattenObject(dec, "", &rec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return rec, io.ErrUnexpectedEOF
+		}
+		return rec, err
+	}
+	return rec, nil
+}
+
+// flattenObject reads the members of an object whose opening brace has been consumed.
+// A key given twice, directly or once flattened ("a.b" and {"a": {"b": …}}), and an empty
+// nested object, which would give no column, are errors.
+func flattenObject(dec *json.Decoder, prefix string, rec *record) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := prefix + tok.(string)
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if _, ok := rec.values[key]; ok {
+			return fmt.Errorf("duplicated key %q", key)
+		}
+		var value any
+		switch tok {
+		case json.Delim('{'):
+			if !dec.More() {
+				return fmt.Errorf("key %q: empty object", key)
+			}
+			if err := flattenObject(dec, key+".", rec); err != nil {
+				return err
+			}
+			continue
+		case json.Delim('['):
+			array, err := decodeArray(dec)
+			if err != nil {
+				return err
+			}
+			text, _ := json.Marshal(array)
+			value = string(text)
+		default:
+			value = tok
+		}
+		rec.keys = append(rec.keys, key)
+		rec.values[key] = value
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// decodeArray reads the elements of an array whose opening bracket has been consumed.
+func decodeArray(dec *json.Decoder) ([]any, error) {
+	out := []any{}
+	for dec.More() {
+		var value any
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		out = append(out, value)
+	}
+	_, err := dec.Token()
+	return out, err
+}
+
+// recordsToDataframe builds a dataframe from the union of the record keys.
+func recordsToDataframe(records []record) *df.Dataframe {
+	var headers []string
+	seen := map[string]bool{}
+	for _, rec := range records {
+		for _, key := range rec.keys {
+			if !seen[key] {
+				seen[key] = true
+				headers = append(headers, key)
+			}
+		}
+	}
+	sheet := make([]series.Series[any], len(headers))
+	for c, header := range headers {
+		values := make([]any, len(records))
+		for r, rec := range records {
+			values[r] = rec.values[header]
+		}
+		sheet[c] = series.InferJSON(values)
+	}
+	return df.New(sheet, headers)
+}
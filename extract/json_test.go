@@ -0,0 +1,112 @@
+package extract
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestExtract_JsonReader(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    string
+		expected *df.Dataframe
+	}{
+		{
+			name:  "flatten and union of keys",
+			value: `[{"id": 1, "user": {"name": "Ana", "address": {"city": "Paris"}}}, {"id": 2, "score": 1.5, "user": {"name": null}}]`,
+			expected: df.New([]series.Series[any]{
+				series.New([]any{1, 2}, "number"),
+				series.NewNullable([]any{"Ana", nil}, "string"),
+				series.NewNullable([]any{"Paris", nil}, "string"),
+				series.NewNullable([]any{nil, 1.5}, "float"),
+			}, []string{"id", "user.name", "user.address.city", "score"}),
+		},
+		{
+			name:  "type inference",
+			value: `[{"n": 1, "f": 2, "b": true, "d": "2024-03-01", "s": "x", "mixed": 1, "tags": ["a", 1]}, {"n": -3, "f": 2.5, "b": false, "d": null, "s": "2024-03-01", "mixed": "y", "tags": []}]`,
+			expected: df.New([]series.Series[any]{
+				series.New([]any{1, -3}, "number"),
+				series.New([]any{2.0, 2.5}, "float"),
+				series.New([]any{true, false}, "bool"),
+				series.NewNullable([]any{day, nil}, "date"),
+				series.New([]any{"x", "2024-03-01"}, "string"),
+				series.New([]any{"1", "y"}, "string"),
+				series.New([]any{`["a",1]`, `[]`}, "string"),
+			}, []string{"n", "f", "b", "d", "s", "mixed", "tags"}),
+		},
+		{name: "empty array", value: `[]`, expected: df.New(nil, nil)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := JsonReader(strings.NewReader(testCase.value))
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if report := df.Diff(got, testCase.expected, df.EqualOptions{}); report != "" {
+				tt.Errorf("Dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestExtract_JsonErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		read     func(io.Reader) (*df.Dataframe, error)
+		value    string
+		expected string
+	}{
+		{"not an array", JsonReader, `{"a": 1}`, "expected an array of objects"},
+		{"not an object", JsonReader, `[{"a": 1}, 2]`, "record 1: expected an object"},
+		{"truncated", JsonReader, `[{"a": 1}`, "json: "},
+		{"ndjson syntax error", NdjsonReader, "{\"a\": 1}\n{\"a\": }\n", "ndjson: record 1"},
+		{"ndjson truncated record", NdjsonReader, "{\"a\": 1}\n{\"a\": 2", "ndjson: record 1: unexpected end of JSON input"},
+		{"ndjson truncated after a key", NdjsonReader, "{\"a\": 1}\n{\"a\"", "ndjson: record 1: unexpected EOF"},
+		{"ndjson two objects on a line", NdjsonReader, "{\"a\": 1}{\"a\": 2}\n", "ndjson: record 0: expected a single object on the line"},
+		{"ndjson object split across lines", NdjsonReader, "{\"a\":\n1}\n", "ndjson: record 0: unexpected EOF"},
+		{"ndjson empty nested object", NdjsonReader, "{\"a\": 1, \"b\": {}}\n", `ndjson: record 0: key "b": empty object`},
+		{"ndjson dotted key collision", NdjsonReader, "{\"a.b\": 1, \"a\": {\"b\": 2}}\n", `ndjson: record 0: duplicated key "a.b"`},
+		{"ndjson duplicated key", NdjsonReader, "{\"a\": 1, \"a\": 2}\n", `ndjson: record 0: duplicated key "a"`},
+		{"empty nested object", JsonReader, `[{"a": {"b": {}}}]`, `json: record 0: key "a.b": empty object`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			_, err := testCase.read(strings.NewReader(testCase.value))
+			if err == nil || !strings.Contains(err.Error(), testCase.expected) {
+				tt.Errorf("Expected an error containing %q, got %v", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestExtract_Ndjson(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	content := "{\"id\": 1, \"meta\": {\"ok\": true}}\n\n{\"id\": 2.5, \"extra\": \"x\"}\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Ndjson(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expected := df.New([]series.Series[any]{
+		series.New([]any{1.0, 2.5}, "float"),
+		series.NewNullable([]any{true, nil}, "bool"),
+		series.NewNullable([]any{nil, "x"}, "string"),
+	}, []string{"id", "meta.ok", "extra"})
+	if report := df.Diff(got, expected, df.EqualOptions{}); report != "" {
+		t.Errorf("Dataframes differ:\n%s", report)
+	}
+	if _, err := Json(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,220 @@
+package extract
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/internal/parquet"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// ParquetFile is an open Parquet file. Its row groups can be read one at a time to process
+// files that do not fit in memory.
+type ParquetFile struct {
+	file   *parquet.File
+	closer io.Closer
+}
+
+// Parquet reads a Parquet file and returns a dataframe holding the given columns, or all
+// of them when none is given (see ParquetReader for the type mapping).
+// Examples:
+//
+//	extract.Parquet("sales.parquet")                   // return dataframe, error
+//	extract.Parquet("sales.parquet", "date", "amount") // only read two columns
+func Parquet(path string, columns ...string) (*df.Dataframe, error) {
+	f, err := OpenParquet(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.ReadAll(columns...)
+}
+
+// ParquetReader reads Parquet data of the given size and returns a dataframe holding the
+// given columns, or all of them when none is given. INT32 and INT64 columns give "number",
+// FLOAT, DOUBLE and decimals give "float", BOOLEAN gives "bool", DATE, TIMESTAMP and INT96
+// give "date" (in UTC) and the other byte arrays give "string". Columns of nested groups
+// get dotted headers (a.b); repeated fields are not supported.
+// Examples:
+//
+//	extract.ParquetReader(bytes.NewReader(data), int64(len(data))) // return dataframe, error
+func ParquetReader(r io.ReaderAt, size int64, columns ...string) (*df.Dataframe, error) {
+	f, err := OpenParquetReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return f.ReadAll(columns...)
+}
+
+// OpenParquet opens a Parquet file and reads its schema. The file must be closed after use.
+// Examples:
+//
+//	f, err := extract.OpenParquet("events.parquet")
+//	defer f.Close()
+//	for frame, err := range f.RowGroups("user", "amount") {
+//		// process each row group
+//	}
+func OpenParquet(path string) (*ParquetFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	f, err := OpenParquetReader(file, info.Size())
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	f.closer = file
+	return f, nil
+}
+
+// OpenParquetReader reads the schema of Parquet data of the given size (see OpenParquet).
+func OpenParquetReader(r io.ReaderAt, size int64) (*ParquetFile, error) {
+	file, err := parquet.Open(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetFile{file: file}, nil
+}
+
+// Close closes the file opened by OpenParquet. It does nothing for OpenParquetReader.
+func (f *ParquetFile) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	return f.closer.Close()
+}
+
+// Headers returns the headers of the columns of the file.
+func (f *ParquetFile) Headers() []string {
+	var out []string
+	for _, field := range f.file.Fields() {
+		out = append(out, field.Name)
+	}
+	return out
+}
+
+// Types returns the type tags of the columns of the file.
+func (f *ParquetFile) Types() []string {
+	var out []string
+	for _, field := range f.file.Fields() {
+		out = append(out, field.Type)
+	}
+	return out
+}
+
+// NumRows returns the number of rows of the file.
+func (f *ParquetFile) NumRows() int {
+	return int(f.file.NumRows())
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (f *ParquetFile) NumRowGroups() int {
+	return f.file.NumRowGroups()
+}
+
+// RowGroup reads a row group and returns a dataframe holding the given columns, or all of
+// them when none is given.
+// Examples:
+//
+//	frame, err := f.RowGroup(0, "amount")
+func (f *ParquetFile) RowGroup(idx int, columns ...string) (*df.Dataframe, error) {
+	if idx < 0 || idx >= f.file.NumRowGroups() {
+		return nil, fmt.Errorf("parquet: row group %d out of range [0, %d)", idx, f.file.NumRowGroups())
+	}
+	return f.read([]int{idx}, columns)
+}
+
+// RowGroups returns an iterator over the row groups of the file, as dataframes holding the
+// given columns (all of them when none is given). The iteration stops after an error.
+// Examples:
+//
+//	for frame, err := range f.RowGroups() {
+//		if err != nil {
+//			return err
+//		}
+//		frame.Debug()
+//	}
+func (f *ParquetFile) RowGroups(columns ...string) iter.Seq2[*df.Dataframe, error] {
+	return func(yield func(*df.Dataframe, error) bool) {
+		for i := range f.file.NumRowGroups() {
+			frame, err := f.read([]int{i}, columns)
+			if !yield(frame, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ReadAll reads every row group and returns a single dataframe holding the given columns,
+// or all of them when none is given.
+func (f *ParquetFile) ReadAll(columns ...string) (*df.Dataframe, error) {
+	groups := make([]int, f.file.NumRowGroups())
+	for i := range groups {
+		groups[i] = i
+	}
+	return f.read(groups, columns)
+}
+
+// read reads the given row groups and concatenates them into a dataframe.
+func (f *ParquetFile) read(groups []int, columns []string) (*df.Dataframe, error) {
+	fields := f.file.Fields()
+	indices, err := projectColumns(fields, columns)
+	if err != nil {
+		return nil, err
+	}
+	rows := 0
+	for _, g := range groups {
+		rows += int(f.file.RowGroupRows(g))
+	}
+	sheet := make([]series.Series[any], len(indices))
+	headers := make([]string, len(indices))
+	for i, col := range indices {
+		values := make([]any, 0, rows)
+		for _, g := range groups {
+			groupValues, err := f.file.ReadColumn(g, col)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, groupValues...)
+		}
+		sheet[i] = series.NewNullable(values, fields[col].Type)
+		headers[i] = fields[col].Name
+	}
+	return df.New(sheet, headers), nil
+}
+
+// projectColumns returns the indices of the requested columns, or of every column when
+// none is requested.
+func projectColumns(fields []parquet.Field, columns []string) ([]int, error) {
+	if len(columns) == 0 {
+		indices := make([]int, len(fields))
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices, nil
+	}
+	positions := map[string]int{}
+	for i, field := range fields {
+		if _, ok := positions[field.Name]; !ok {
+			positions[field.Name] = i
+		}
+	}
+	indices := make([]int, len(columns))
+	for i, name := range columns {
+		pos, ok := positions[name]
+		if !ok {
+			return nil, fmt.Errorf("parquet: unknown column %q", name)
+		}
+		indices[i] = pos
+	}
+	return indices, nil
+}
@@ -0,0 +1,115 @@
+package extract
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/load"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func writeParquet(t *testing.T, frame *df.Dataframe, opts load.ParquetOptions) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "data.parquet")
+	if err := load.Parquet(frame, path, opts); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	return path
+}
+
+func salesFixture() *df.Dataframe {
+	return df.New([]series.Series[any]{
+		series.New([]any{1, 2, 3, 4, 5}, "number"),
+		series.New([]any{"FR", "BE", "FR", "DE", "FR"}, "string"),
+		series.NewNullable([]any{10.5, nil, 7.25, 3.0, 1.0}, "float"),
+	}, []string{"id", "country", "amount"})
+}
+
+func TestExtract_Parquet(t *testing.T) {
+	path := writeParquet(t, salesFixture(), load.ParquetOptions{})
+	testCases := []struct {
+		name     string
+		columns  []string
+		expected *df.Dataframe
+	}{
+		{"all columns", nil, salesFixture()},
+		{"projection", []string{"amount", "id"}, df.New([]series.Series[any]{
+			series.NewNullable([]any{10.5, nil, 7.25, 3.0, 1.0}, "float"),
+			series.New([]any{1, 2, 3, 4, 5}, "number"),
+		}, []string{"amount", "id"})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Parquet(path, tc.columns...)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, tc.expected, df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+	if _, err := Parquet(path, "price"); err == nil || !strings.Contains(err.Error(), `unknown column "price"`) {
+		t.Errorf("expected an unknown column error, got %v", err)
+	}
+	if _, err := Parquet(filepath.Join(t.TempDir(), "missing.parquet")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+func TestExtract_ParquetFile(t *testing.T) {
+	f, err := OpenParquet(writeParquet(t, salesFixture(), load.ParquetOptions{RowGroupSize: 2}))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer f.Close()
+
+	if f.NumRows() != 5 || f.NumRowGroups() != 3 {
+		t.Errorf("expected 5 rows in 3 row groups, got %d in %d", f.NumRows(), f.NumRowGroups())
+	}
+	if !is.SameSlice(f.Headers(), []string{"id", "country", "amount"}) || !is.SameSlice(f.Types(), []string{"number", "string", "float"}) {
+		t.Errorf("unexpected schema %v %v", f.Headers(), f.Types())
+	}
+
+	var ids [][]any
+	for frame, err := range f.RowGroups("id") {
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		s, _ := frame.GetSeries(0)
+		ids = append(ids, s.ToSlice())
+	}
+	if !is.Equal(ids, [][]any{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("expected the ids of each row group, got %v", ids)
+	}
+
+	frame, err := f.RowGroup(1, "country")
+	if err != nil || frame.Shape()[0] != 2 {
+		t.Fatalf("expected 2 rows, got %v (%v)", frame, err)
+	}
+	if _, err := f.RowGroup(3); err == nil {
+		t.Errorf("expected an out of range error")
+	}
+}
+
+func TestExtract_ParquetReader(t *testing.T) {
+	var buf bytes.Buffer
+	empty := df.New([]series.Series[any]{series.New([]any{}, "date")}, []string{"at"})
+	if err := load.ParquetWriter(&buf, empty, load.ParquetOptions{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got, err := ParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if report := df.Diff(got, empty, df.EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+	if _, err := ParquetReader(strings.NewReader("not parquet at all"), 18); err == nil {
+		t.Errorf("expected an error for invalid data")
+	}
+}
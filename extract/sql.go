@@ -0,0 +1,314 @@
+package extract
+
+import (
+	"database/sql"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// Sql runs a query on a database and returns its result as a dataframe (see SqlRows for
+// the type mapping).
+// Examples:
+//
+//	db, _ := sql.Open("sqlite", "ref.db")
+//	extract.Sql(db, "SELECT id, name FROM country WHERE continent = ?", "EU") // return dataframe, error
+func Sql(db *sql.DB, query string, args ...any) (*df.Dataframe, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sql: %w", err)
+	}
+	return SqlRows(rows)
+}
+
+// SqlRows reads every row of a result set into a dataframe and closes it. The type tag of
+// each column comes from the database type reported by the driver: integer types give
+// "number", floating point, decimal and numeric types give "float", booleans give "bool",
+// dates and timestamps give "date" and the other types give "string". Without a database
+// type, the Go scan type of the driver is used, then the scanned values as a last resort.
+// NULL values (sql.Null* with Valid false) become nulls.
+// Examples:
+//
+//	rows, err := db.QueryContext(ctx, "SELECT * FROM rates")
+//	frame, err := extract.SqlRows(rows)
+func SqlRows(rows *sql.Rows) (*df.Dataframe, error) {
+	defer rows.Close()
+	r, err := newSqlReader(rows)
+	if err != nil {
+		return nil, err
+	}
+	frame, _, err := r.read(0)
+	return frame, err
+}
+
+// SqlChunks runs a query on a database and returns an iterator over its result, as
+// dataframes of at most chunkSize rows, to process result sets that do not fit in memory.
+// The iteration stops after an error.
+// Examples:
+//
+//	for frame, err := range extract.SqlChunks(db, 10000, "SELECT * FROM events") {
+//		if err != nil {
+//			return err
+//		}
+//		frame.Debug()
+//	}
+func SqlChunks(db *sql.DB, chunkSize int, query string, args ...any) iter.Seq2[*df.Dataframe, error] {
+	return func(yield func(*df.Dataframe, error) bool) {
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			yield(nil, fmt.Errorf("sql: %w", err))
+			return
+		}
+		SqlRowsChunks(rows, chunkSize)(yield)
+	}
+}
+
+// SqlRowsChunks returns an iterator over a result set, as dataframes of at most chunkSize
+// rows (see SqlRows for the type mapping). The result set is closed at the end of the
+// iteration. The iteration stops after an error.
+func SqlRowsChunks(rows *sql.Rows, chunkSize int) iter.Seq2[*df.Dataframe, error] {
+	return func(yield func(*df.Dataframe, error) bool) {
+		defer rows.Close()
+		if chunkSize <= 0 {
+			yield(nil, fmt.Errorf("sql: invalid chunk size %d", chunkSize))
+			return
+		}
+		r, err := newSqlReader(rows)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for {
+			frame, n, err := r.read(chunkSize)
+			if n == 0 && err == nil {
+				return
+			}
+			if !yield(frame, err) || err != nil || n < chunkSize {
+				return
+			}
+		}
+	}
+}
+
+// sqlReader reads a result set into dataframes. Columns whose type cannot be told from the
+// driver get the type inferred from the first values read, "string" when they are all null,
+// and keep it for the next chunks.
+type sqlReader struct {
+	rows    *sql.Rows
+	headers []string
+	types   []string
+	row     int // rows read so far
+}
+
+func newSqlReader(rows *sql.Rows) (*sqlReader, error) {
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("sql: %w", err)
+	}
+	r := &sqlReader{rows: rows}
+	for _, c := range columns {
+		r.headers = append(r.headers, c.Name())
+		r.types = append(r.types, sqlColumnType(c))
+	}
+	return r, nil
+}
+
+// read reads at most limit rows, or every row when limit is 0, and returns them with
+// their count.
+func (r *sqlReader) read(limit int) (*df.Dataframe, int, error) {
+	columns := make([][]any, len(r.headers))
+	dest := make([]any, len(r.headers))
+	values := make([]any, len(r.headers))
+	for i := range dest {
+		dest[i] = &values[i]
+	}
+	n := 0
+	for (limit == 0 || n < limit) && r.rows.Next() {
+		if err := r.rows.Scan(dest...); err != nil {
+			return nil, n, fmt.Errorf("sql: row %d: %w", r.row, err)
+		}
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			columns[i] = append(columns[i], v)
+		}
+		n++
+		r.row++
+	}
+	if err := r.rows.Err(); err != nil {
+		return nil, n, fmt.Errorf("sql: %w", err)
+	}
+
+	sheet := make([]series.Series[any], len(r.headers))
+	for i, values := range columns {
+		if r.types[i] == "" {
+			// settled on the first chunk so that every chunk has the same types
+			r.types[i] = inferSqlColumn(values)
+		}
+		t := r.types[i]
+		out := make([]any, len(values))
+		for row, v := range values {
+			if v == nil {
+				continue
+			}
+			converted, err := sqlValue(v, t)
+			if err != nil {
+				return nil, n, fmt.Errorf("sql: column %q: row %d: %w", r.headers[i], r.row-n+row, err)
+			}
+			out[row] = converted
+		}
+		sheet[i] = series.NewNullable(out, t)
+	}
+	return df.New(sheet, r.headers), n, nil
+}
+
+// sqlTypes maps database type names, without their parameters ("VARCHAR(20)" is
+// "VARCHAR") and UNSIGNED attribute, to type tags. Other names give "string".
+var sqlTypes = map[string]string{
+	"BOOL": "bool", "BOOLEAN": "bool",
+	"INT": "number", "INTEGER": "number", "TINYINT": "number", "SMALLINT": "number", "MEDIUMINT": "number",
+	"BIGINT": "number", "INT2": "number", "INT4": "number", "INT8": "number", "SERIAL": "number",
+	"SMALLSERIAL": "number", "BIGSERIAL": "number", "SERIAL2": "number", "SERIAL4": "number", "SERIAL8": "number",
+	"FLOAT": "float", "FLOAT4": "float", "FLOAT8": "float", "REAL": "float", "DOUBLE": "float",
+	"DOUBLE PRECISION": "float", "NUMERIC": "float", "DECIMAL": "float", "DEC": "float", "NUMBER": "float",
+	"MONEY": "float", "SMALLMONEY": "float",
+	"DATE": "date", "DATETIME": "date", "DATETIME2": "date", "SMALLDATETIME": "date", "DATETIMEOFFSET": "date",
+	"TIMESTAMP": "date", "TIMESTAMPTZ": "date",
+}
+
+// sqlColumnType returns the type tag of a column from its database type name, then from
+// its scan type. It returns "" when neither is known.
+func sqlColumnType(c *sql.ColumnType) string {
+	name := strings.ToUpper(c.DatabaseTypeName())
+	if before, _, ok := strings.Cut(name, "("); ok {
+		// VARCHAR(20), TIMESTAMP(6) WITH TIME ZONE
+		name = before
+	}
+	name = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(name, "UNSIGNED "), " UNSIGNED"))
+	if strings.HasPrefix(name, "TIMESTAMP ") {
+		// TIMESTAMP WITH TIME ZONE, TIMESTAMP WITHOUT TIME ZONE
+		name = "TIMESTAMP"
+	}
+	if name != "" {
+		if t, ok := sqlTypes[name]; ok {
+			return t
+		}
+		return "string"
+	}
+
+	scan := c.ScanType()
+	if scan == nil {
+		return ""
+	}
+	switch scan {
+	case reflect.TypeOf(time.Time{}), reflect.TypeOf(sql.NullTime{}):
+		return "date"
+	case reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullInt32{}), reflect.TypeOf(sql.NullInt16{}), reflect.TypeOf(sql.NullByte{}):
+		return "number"
+	case reflect.TypeOf(sql.NullFloat64{}):
+		return "float"
+	case reflect.TypeOf(sql.NullBool{}):
+		return "bool"
+	case reflect.TypeOf(sql.NullString{}), reflect.TypeOf(sql.RawBytes{}), reflect.TypeOf([]byte{}):
+		return "string"
+	}
+	switch scan.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "number"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Bool:
+		return "bool"
+	case reflect.String:
+		return "string"
+	}
+	return ""
+}
+
+// inferSqlColumn returns the type tag of a column from the scanned values, "string" when
+// they are all null.
+func inferSqlColumn(values []any) string {
+	kinds := map[string]bool{}
+	for _, v := range values {
+		switch v.(type) {
+		case nil:
+		case int64:
+			kinds["number"] = true
+		case float64:
+			kinds["float"] = true
+		case bool:
+			kinds["bool"] = true
+		case time.Time:
+			kinds["date"] = true
+		default:
+			kinds["string"] = true
+		}
+	}
+	switch {
+	case len(kinds) == 2 && kinds["number"] && kinds["float"]:
+		return "float"
+	case len(kinds) == 1:
+		for k := range kinds {
+			return k
+		}
+	}
+	return "string"
+}
+
+// sqlValue converts a non-null scanned value (int64, float64, bool, string or time.Time)
+// to the canonical Go value of the type tag t.
+func sqlValue(v any, t string) (any, error) {
+	switch t {
+	case "number":
+		switch x := v.(type) {
+		case int64:
+			return int(x), nil
+		case float64:
+			if x == float64(int(x)) {
+				return int(x), nil
+			}
+		case string:
+			if n, err := strconv.Atoi(strings.TrimSpace(x)); err == nil {
+				return n, nil
+			}
+		}
+	case "float":
+		switch x := v.(type) {
+		case float64:
+			return x, nil
+		case int64:
+			return float64(x), nil
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(x), 64); err == nil {
+				return f, nil
+			}
+		}
+	case "bool":
+		switch x := v.(type) {
+		case bool:
+			return x, nil
+		case int64:
+			return x != 0, nil
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(x)); err == nil {
+				return b, nil
+			}
+		}
+	case "date":
+		return series.ParseDate(v)
+	case "string":
+		if tm, ok := v.(time.Time); ok {
+			return tm.Format(time.RFC3339Nano), nil
+		}
+		return fmt.Sprint(v), nil
+	}
+	return nil, fmt.Errorf("cannot use %v (%T) as %s", v, v, t)
+}
@@ -0,0 +1,254 @@
+package extract
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// fakeResult is a result set of the fake driver. Empty database type names and nil scan
+// types are reported as unknown.
+type fakeResult struct {
+	columns   []string
+	typeNames []string
+	scanTypes []reflect.Type
+	rows      [][]driver.Value
+}
+
+// fakeConnector is an in-process driver answering the queries of its results map.
+type fakeConnector struct{ results map[string]fakeResult }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn fakeConnector
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	result, ok := c.results[query]
+	if !ok {
+		return nil, errors.New("no such table")
+	}
+	return fakeStmt{result}, nil
+}
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("read only") }
+
+type fakeStmt struct{ result fakeResult }
+
+func (s fakeStmt) Close() error                               { return nil }
+func (s fakeStmt) NumInput() int                              { return -1 }
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, errors.New("read only") }
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return &fakeRows{result: s.result}, nil }
+
+type fakeRows struct {
+	result fakeResult
+	next   int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+	return nil
+}
+func (r *fakeRows) ColumnTypeDatabaseTypeName(i int) string {
+	if r.result.typeNames == nil {
+		return ""
+	}
+	return r.result.typeNames[i]
+}
+func (r *fakeRows) ColumnTypeScanType(i int) reflect.Type {
+	if r.result.scanTypes == nil || r.result.scanTypes[i] == nil {
+		return reflect.TypeFor[any]()
+	}
+	return r.result.scanTypes[i]
+}
+
+func fakeDB(results map[string]fakeResult) *sql.DB {
+	return sql.OpenDB(fakeConnector{results})
+}
+
+func TestExtract_Sql(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	db := fakeDB(map[string]fakeResult{
+		"typed": {
+			columns:   []string{"id", "rate", "active", "since", "code", "at"},
+			typeNames: []string{"BIGINT", "NUMERIC", "BOOL", "TIMESTAMPTZ", "VARCHAR", "TIME"},
+			rows: [][]driver.Value{
+				{int64(1), []byte("1.25"), true, day, "EUR", "10:00:00"},
+				{int64(2), nil, nil, nil, []byte("USD"), nil},
+				{nil, float64(3), false, "2024-03-02", nil, "11:30:00"},
+			},
+		},
+		"type names": {
+			columns: []string{"p", "mp", "r", "a", "tr", "dr", "u", "v", "ts", "d"},
+			typeNames: []string{"POINT", "MULTIPOINT", "INT4RANGE", "_INT4", "TSTZRANGE", "DATERANGE",
+				"UNSIGNED BIGINT", "VARCHAR(20)", "timestamp(3) with time zone", "DECIMAL(10,2)"},
+			rows: [][]driver.Value{{
+				[]byte("(1,2)"), []byte("((1,2))"), "[1,5)", "{1,2}", "[2024-03-01,)", "[2024-03-01,2024-03-05)",
+				int64(7), "abc", day, []byte("3.50"),
+			}},
+		},
+		"scan types": {
+			columns:   []string{"n", "f", "b", "s", "d"},
+			typeNames: []string{"", "", "", "", ""},
+			scanTypes: []reflect.Type{
+				reflect.TypeFor[sql.NullInt32](), reflect.TypeFor[float32](), reflect.TypeFor[sql.NullBool](),
+				reflect.TypeFor[sql.RawBytes](), reflect.TypeFor[sql.NullTime](),
+			},
+			rows: [][]driver.Value{{int64(7), 0.5, int64(1), []byte("x"), day}, {nil, nil, nil, nil, nil}},
+		},
+		"untyped": {
+			columns: []string{"n", "f", "s", "mixed", "empty"},
+			rows: [][]driver.Value{
+				{int64(1), int64(1), "a", int64(1), nil},
+				{nil, 2.5, "b", "two", nil},
+			},
+		},
+	})
+	defer db.Close()
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected *df.Dataframe
+	}{
+		{"database types", "typed", df.New([]series.Series[any]{
+			series.NewNullable([]any{1, 2, nil}, "number"),
+			series.NewNullable([]any{1.25, nil, 3.0}, "float"),
+			series.NewNullable([]any{true, nil, false}, "bool"),
+			series.NewNullable([]any{day, nil, day.AddDate(0, 0, 1)}, "date"),
+			series.NewNullable([]any{"EUR", "USD", nil}, "string"),
+			series.NewNullable([]any{"10:00:00", nil, "11:30:00"}, "string"),
+		}, []string{"id", "rate", "active", "since", "code", "at"})},
+		{"type names", "type names", df.New([]series.Series[any]{
+			series.New([]any{"(1,2)"}, "string"),
+			series.New([]any{"((1,2))"}, "string"),
+			series.New([]any{"[1,5)"}, "string"),
+			series.New([]any{"{1,2}"}, "string"),
+			series.New([]any{"[2024-03-01,)"}, "string"),
+			series.New([]any{"[2024-03-01,2024-03-05)"}, "string"),
+			series.New([]any{7}, "number"),
+			series.New([]any{"abc"}, "string"),
+			series.New([]any{day}, "date"),
+			series.New([]any{3.5}, "float"),
+		}, []string{"p", "mp", "r", "a", "tr", "dr", "u", "v", "ts", "d"})},
+		{"scan types", "scan types", df.New([]series.Series[any]{
+			series.NewNullable([]any{7, nil}, "number"),
+			series.NewNullable([]any{0.5, nil}, "float"),
+			series.NewNullable([]any{true, nil}, "bool"),
+			series.NewNullable([]any{"x", nil}, "string"),
+			series.NewNullable([]any{day, nil}, "date"),
+		}, []string{"n", "f", "b", "s", "d"})},
+		{"inferred from the values", "untyped", df.New([]series.Series[any]{
+			series.NewNullable([]any{1, nil}, "number"),
+			series.NewNullable([]any{1.0, 2.5}, "float"),
+			series.NewNullable([]any{"a", "b"}, "string"),
+			series.NewNullable([]any{"1", "two"}, "string"),
+			series.NewNullable([]any{nil, nil}, "string"),
+		}, []string{"n", "f", "s", "mixed", "empty"})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Sql(db, tc.query)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, tc.expected, df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestExtract_Sql_errors(t *testing.T) {
+	db := fakeDB(map[string]fakeResult{
+		"bad": {columns: []string{"id"}, typeNames: []string{"INTEGER"}, rows: [][]driver.Value{{int64(1)}, {"abc"}}},
+	})
+	defer db.Close()
+
+	testCases := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{"query", "missing", "sql: no such table"},
+		{"conversion", "bad", `sql: column "id": row 1: cannot use abc (string) as number`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Sql(db, tc.query)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExtract_SqlChunks(t *testing.T) {
+	var rows [][]driver.Value
+	for i := range 5 {
+		// note is null in the first chunk only
+		var note driver.Value
+		if i >= 2 {
+			note = int64(i)
+		}
+		rows = append(rows, []driver.Value{int64(i), note})
+	}
+	db := fakeDB(map[string]fakeResult{"ids": {columns: []string{"id", "note"}, rows: rows}})
+	defer db.Close()
+
+	var ids, notes [][]any
+	for frame, err := range SqlChunks(db, 2, "ids") {
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		s, _ := frame.GetSeries(0)
+		ids = append(ids, s.ToSlice())
+		note, _ := frame.GetSeries(1)
+		notes = append(notes, note.ToSlice())
+		if note.Type() != "string" {
+			t.Errorf("expected the string type of the first chunk, got %s", note.Type())
+		}
+	}
+	if !is.Equal(ids, [][]any{{0, 1}, {2, 3}, {4}}) {
+		t.Errorf("unexpected chunks %v", ids)
+	}
+	if !is.Equal(notes, [][]any{{nil, nil}, {"2", "3"}, {"4"}}) {
+		t.Errorf("unexpected notes %v", notes)
+	}
+
+	chunks := 0
+	for range SqlChunks(db, 2, "ids") {
+		chunks++
+		break
+	}
+	if chunks != 1 {
+		t.Errorf("expected the iteration to stop, got %d chunks", chunks)
+	}
+
+	for _, err := range SqlChunks(db, 0, "ids") {
+		if err == nil || !strings.Contains(err.Error(), "invalid chunk size 0") {
+			t.Errorf("expected an invalid chunk size error, got %v", err)
+		}
+	}
+	for _, err := range SqlChunks(db, 2, "missing") {
+		if err == nil {
+			t.Errorf("expected a query error")
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package fn
+
+import "fmt"
+
+// Chunk splits a slice into consecutive slices of size elements; the last one may be
+// shorter. It panics if size is below 1.
+// Examples:
+//
+//	fn.Chunk([]int{1, 2, 3}, 2) // [[1 2] [3]]
+func Chunk[T any](slice []T, size int) [][]T {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid chunk size %d", size))
+	}
+	out := make([][]T, 0, (len(slice)+size-1)/size)
+	for start := 0; start < len(slice); start += size {
+		end := min(start+size, len(slice))
+		out = append(out, append([]T(nil), slice[start:end]...))
+	}
+	return out
+}
+
+// Window returns every run of size consecutive elements (a sliding window moving one
+// element at a time). A slice shorter than size gives no window. It panics if size is
+// below 1.
+// Examples:
+//
+//	fn.Window([]int{1, 2, 3, 4}, 3) // [[1 2 3] [2 3 4]]
+func Window[T any](slice []T, size int) [][]T {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid window size %d", size))
+	}
+	out := make([][]T, 0, max(len(slice)-size+1, 0))
+	for start := 0; start+size <= len(slice); start++ {
+		out = append(out, append([]T(nil), slice[start:start+size]...))
+	}
+	return out
+}
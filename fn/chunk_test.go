@@ -0,0 +1,60 @@
+package fn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFn_Chunk(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []int
+		size     int
+		expected [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+		{"larger than slice", []int{1}, 3, [][]int{{1}}},
+		{"empty", []int{}, 2, [][]int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Chunk(testCase.value, testCase.size)
+			if !reflect.DeepEqual(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_Window(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []int
+		size     int
+		expected [][]int
+	}{
+		{"size 3", []int{1, 2, 3, 4}, 3, [][]int{{1, 2, 3}, {2, 3, 4}}},
+		{"size 1", []int{1, 2}, 1, [][]int{{1}, {2}}},
+		{"too short", []int{1, 2}, 3, [][]int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Window(testCase.value, testCase.size)
+			if !reflect.DeepEqual(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_ChunkInvalidSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic for size 0")
+		}
+	}()
+	Chunk([]int{1}, 0)
+}
@@ -1,3 +1,5 @@
 // Package fn provides functional utilities for working with slices and generic series.
-// It includes helpers such as Map, Filter, Reduce, Any, All, IndexOf, and Reverse.
+// It includes helpers such as Map, Filter, Reduce, Scan, Any, All, IndexOf, and Reverse,
+// their concurrent counterparts ParallelMap, ParallelFilter and ParallelReduce, and
+// collection utilities such as GroupBy, Partition, Chunk, Zip, Uniq and SortBy.
 package fn
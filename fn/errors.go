@@ -0,0 +1,19 @@
+package fn
+
+import "fmt"
+
+// IndexError reports the error returned by a callback together with the index of the
+// element it was called with. Use errors.As to retrieve it.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned by the callback.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
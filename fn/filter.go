@@ -1,6 +1,10 @@
 package fn
 
-import "github.com/visual-pivert/go-starter/is"
+import (
+	"errors"
+
+	"github.com/visual-pivert/go-starter/is"
+)
 
 // Filter returns a new slice with all elements that satisfy the predicate function.
 // Examples:
@@ -71,3 +75,50 @@ func FilterToBoolStatement[T any](slice []T, fn func(T) bool) []bool {
 	}
 	return out
 }
+
+// FilterErr returns a new slice with all elements that satisfy a fallible predicate.
+// It stops at the first error and returns it as an *IndexError.
+// Examples:
+//
+//	fn.FilterErr([]string{"1", "5"}, func(v string) (bool, error) {
+//		n, err := strconv.Atoi(v)
+//		return n > 2, err
+//	}) // [5], nil
+func FilterErr[T any](slice []T, fn func(T) (bool, error)) ([]T, error) {
+	var out []T
+	for i, value := range slice {
+		keep, err := fn(value)
+		if err != nil {
+			return nil, &IndexError{i, err}
+		}
+		if keep {
+			out = append(out, value)
+		}
+	}
+	return out, nil
+}
+
+// FilterErrAll evaluates a fallible predicate on every element of a slice, whatever the
+// errors. Failed elements are dropped and every error is returned as an *IndexError
+// joined with errors.Join.
+// Examples:
+//
+//	fn.FilterErrAll([]string{"1", "x", "5"}, func(v string) (bool, error) {
+//		n, err := strconv.Atoi(v)
+//		return n > 2, err
+//	}) // [5], index 1: ...
+func FilterErrAll[T any](slice []T, fn func(T) (bool, error)) ([]T, error) {
+	var out []T
+	var errs []error
+	for i, value := range slice {
+		keep, err := fn(value)
+		if err != nil {
+			errs = append(errs, &IndexError{i, err})
+			continue
+		}
+		if keep {
+			out = append(out, value)
+		}
+	}
+	return out, errors.Join(errs...)
+}
@@ -1,6 +1,9 @@
 package fn
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/visual-pivert/go-starter/is"
@@ -111,3 +114,48 @@ func TestFn_FilterToBoolStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestFn_FilterErr(t *testing.T) {
+	greaterThan2 := func(v string) (bool, error) {
+		n, err := strconv.Atoi(v)
+		return n > 2, err
+	}
+	testCases := []struct {
+		name          string
+		fn            func([]string, func(string) (bool, error)) ([]string, error)
+		value         []string
+		expected      []string
+		expectedIndex []int
+	}{
+		{"all valid", FilterErr[string], []string{"1", "5", "3"}, []string{"5", "3"}, nil},
+		{"stop at first error", FilterErr[string], []string{"5", "x", "y"}, nil, []int{1}},
+		{"collect all errors", FilterErrAll[string], []string{"5", "x", "1", "y"}, []string{"5"}, []int{1, 3}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := testCase.fn(testCase.value, greaterThan2)
+			if !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+			var indices []int
+			if err != nil {
+				for _, line := range strings.Split(err.Error(), "\n") {
+					var idx int
+					fmt.Sscanf(line, "index %d:", &idx)
+					indices = append(indices, idx)
+				}
+			}
+			if !is.SameSlice(indices, testCase.expectedIndex) {
+				tt.Errorf("Expected errors at %v, got %v", testCase.expectedIndex, err)
+			}
+		})
+	}
+}
+
+func TestFn_FilterPredicates(t *testing.T) {
+	got := Filter([]string{"", "ana@example.com", "bob", "https://example.com"}, is.And(is.Not(is.Empty[string]), is.Or(is.Email, is.URL)))
+	if !is.SameSlice(got, []string{"ana@example.com", "https://example.com"}) {
+		t.Errorf("Expected [ana@example.com https://example.com], got %v", got)
+	}
+}
@@ -0,0 +1,17 @@
+package fn
+
+// Flatten concatenates a slice of slices into a single slice.
+// Examples:
+//
+//	fn.Flatten([][]int{{1, 2}, {}, {3}}) // [1 2 3]
+func Flatten[T any](slices [][]T) []T {
+	size := 0
+	for _, s := range slices {
+		size += len(s)
+	}
+	out := make([]T, 0, size)
+	for _, s := range slices {
+		out = append(out, s...)
+	}
+	return out
+}
@@ -0,0 +1,27 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Flatten(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    [][]int
+		expected []int
+	}{
+		{"nested", [][]int{{1, 2}, {}, {3}}, []int{1, 2, 3}},
+		{"empty", [][]int{}, []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Flatten(testCase.value)
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
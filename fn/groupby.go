@@ -0,0 +1,40 @@
+package fn
+
+// GroupBy groups the elements of a slice by the key returned by fn, keeping their order
+// within each group.
+// Examples:
+//
+//	fn.GroupBy([]int{1, 2, 3, 4}, func(v int, _ int) bool { return v%2 == 0 }) // map[false:[1 3] true:[2 4]]
+func GroupBy[T any, K comparable](slice []T, fn func(value T, idx int) K) map[K][]T {
+	out := make(map[K][]T)
+	for i, value := range slice {
+		key := fn(value, i)
+		out[key] = append(out[key], value)
+	}
+	return out
+}
+
+// KeyBy indexes the elements of a slice by the key returned by fn; when several elements
+// share a key, the last one wins.
+// Examples:
+//
+//	fn.KeyBy([]string{"ab", "c"}, func(v string, _ int) int { return len(v) }) // map[1:c 2:ab]
+func KeyBy[T any, K comparable](slice []T, fn func(value T, idx int) K) map[K]T {
+	out := make(map[K]T, len(slice))
+	for i, value := range slice {
+		out[fn(value, i)] = value
+	}
+	return out
+}
+
+// CountBy counts the elements of a slice by the key returned by fn.
+// Examples:
+//
+//	fn.CountBy([]string{"a", "bb", "c"}, func(v string, _ int) int { return len(v) }) // map[1:2 2:1]
+func CountBy[T any, K comparable](slice []T, fn func(value T, idx int) K) map[K]int {
+	out := make(map[K]int)
+	for i, value := range slice {
+		out[fn(value, i)]++
+	}
+	return out
+}
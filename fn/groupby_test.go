@@ -0,0 +1,42 @@
+package fn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFn_GroupBy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []int
+		expected map[bool][]int
+	}{
+		{"even and odd", []int{1, 2, 3, 4}, map[bool][]int{false: {1, 3}, true: {2, 4}}},
+		{"empty", []int{}, map[bool][]int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := GroupBy(testCase.value, func(v int, _ int) bool { return v%2 == 0 })
+			if !reflect.DeepEqual(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_KeyBy(t *testing.T) {
+	got := KeyBy([]string{"ab", "c", "de"}, func(v string, _ int) int { return len(v) })
+	expected := map[int]string{1: "c", 2: "de"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestFn_CountBy(t *testing.T) {
+	got := CountBy([]string{"a", "bb", "c"}, func(v string, idx int) int { return len(v) })
+	expected := map[int]int{1: 2, 2: 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
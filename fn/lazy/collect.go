@@ -0,0 +1,70 @@
+package lazy
+
+import (
+	"iter"
+	"reflect"
+
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// Collect returns the values of a sequence as a slice.
+// Examples:
+//
+//	lazy.Collect(lazy.Take(lazy.From([]int{1, 2, 3}), 2)) // [1, 2]
+func Collect[T any](seq iter.Seq[T]) []T {
+	out := []T{}
+	for value := range seq {
+		out = append(out, value)
+	}
+	return out
+}
+
+// ToSeries returns the values of a sequence as a Series of type t.
+// Examples:
+//
+//	lazy.ToSeries(lazy.From([]int{1, 2}), "number") // Series of type number with values [1, 2]
+func ToSeries[T any](seq iter.Seq[T], t string) series.Series[T] {
+	return series.New(Collect(seq), t)
+}
+
+// Any returns true if at least one value satisfies the predicate. It stops at the first match.
+// Examples:
+//
+//	lazy.Any(lazy.From([]int{1, 2, 3}), func(v int) bool { return v > 2 }) // true
+func Any[T any](seq iter.Seq[T], fn func(T) bool) bool {
+	for value := range seq {
+		if fn(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if all values satisfy the predicate. It stops at the first mismatch.
+// Examples:
+//
+//	lazy.All(lazy.From([]int{1, 2, 3}), func(v int) bool { return v > 0 }) // true
+func All[T any](seq iter.Seq[T], fn func(T) bool) bool {
+	for value := range seq {
+		if !fn(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOf returns the position of the first occurrence of value in the sequence,
+// or -1 if it is not present. It stops at the first match.
+// Examples:
+//
+//	lazy.IndexOf(2, lazy.From([]int{1, 2, 3})) // 1
+func IndexOf[T any](value T, seq iter.Seq[T]) int {
+	i := 0
+	for v := range seq {
+		if reflect.DeepEqual(v, value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
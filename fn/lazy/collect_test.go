@@ -0,0 +1,43 @@
+package lazy
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestLazy_ToSeries(t *testing.T) {
+	s := series.New([]int{1, 2, 3}, "number")
+	got := ToSeries(Filter(s.Values(), func(v int) bool { return v > 1 }), s.Type())
+	if !is.SameSlice(got.ToSlice(), []int{2, 3}) || got.Type() != "number" {
+		t.Errorf("Expected number [2 3], got %v %v", got.Type(), got.ToSlice())
+	}
+}
+
+func TestLazy_ShortCircuit(t *testing.T) {
+	testCases := []struct {
+		name          string
+		run           func(produced *int) any
+		expected      any
+		expectedCount int
+	}{
+		{"any", func(p *int) any { return Any(naturals(p), func(v int) bool { return v == 3 }) }, true, 4},
+		{"all", func(p *int) any { return All(naturals(p), func(v int) bool { return v < 2 }) }, false, 3},
+		{"index of", func(p *int) any { return IndexOf(4, naturals(p)) }, 4, 5},
+		{"any empty", func(p *int) any { return Any(Take(naturals(p), 0), func(v int) bool { return true }) }, false, 0},
+		{"index of missing", func(p *int) any { return IndexOf(9, Take(naturals(p), 3)) }, -1, 3},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			produced := 0
+			got := testCase.run(&produced)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+			if produced != testCase.expectedCount {
+				tt.Errorf("Expected %d values produced, got %d", testCase.expectedCount, produced)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package lazy provides iterator-based counterparts of the fn helpers built on iter.Seq
+// and iter.Seq2. Adapters such as Map, Filter, Take or Chunk do not allocate intermediate
+// slices: values flow one at a time from the source to a collector (Collect, ToSeries)
+// or to a short-circuiting consumer (Any, All, IndexOf).
+package lazy
@@ -0,0 +1,102 @@
+package lazy
+
+import "iter"
+
+// From returns a sequence over the values of a slice.
+// Examples:
+//
+//	lazy.From([]int{1, 2, 3}) // yields 1, 2, 3
+func From[T any](slice []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range slice {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence with the results of fn applied to each value and its index.
+// Examples:
+//
+//	lazy.Map(lazy.From([]int{1, 2, 3}), func(v int, _ int) int { return v * 2 }) // yields 2, 4, 6
+func Map[T any, U any](seq iter.Seq[T], fn func(value T, idx int) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		i := 0
+		for value := range seq {
+			if !yield(fn(value, i)) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Filter returns a sequence with the values that satisfy the predicate.
+// Examples:
+//
+//	lazy.Filter(lazy.From([]int{1, 2, 3}), func(v int) bool { return v > 1 }) // yields 2, 3
+func Filter[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if fn(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns a sequence with the values of the sequences returned by fn for each
+// value and its index.
+// Examples:
+//
+//	lazy.FlatMap(lazy.From([]int{1, 2}), func(v int, _ int) iter.Seq[int] {
+//		return lazy.From([]int{v, v * 10})
+//	}) // yields 1, 10, 2, 20
+func FlatMap[T any, U any](seq iter.Seq[T], fn func(value T, idx int) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		i := 0
+		for value := range seq {
+			for inner := range fn(value, i) {
+				if !yield(inner) {
+					return
+				}
+			}
+			i++
+		}
+	}
+}
+
+// Enumerate returns a sequence of (index, value) pairs.
+// Examples:
+//
+//	for i, v := range lazy.Enumerate(lazy.From([]string{"a", "b"})) {} // (0, a), (1, b)
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range seq {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Zip returns a sequence of pairs taking one value from each sequence; it stops with
+// the shorter one.
+// Examples:
+//
+//	lazy.Zip(lazy.From([]string{"a", "b"}), lazy.From([]int{1, 2, 3})) // yields (a, 1), (b, 2)
+func Zip[T any, U any](left iter.Seq[T], right iter.Seq[U]) iter.Seq2[T, U] {
+	return func(yield func(T, U) bool) {
+		next, stop := iter.Pull(right)
+		defer stop()
+		for l := range left {
+			r, ok := next()
+			if !ok || !yield(l, r) {
+				return
+			}
+		}
+	}
+}
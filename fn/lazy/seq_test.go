@@ -0,0 +1,85 @@
+package lazy
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// naturals yields 0, 1, 2, ... and counts how many values were produced.
+func naturals(produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestLazy_Seq(t *testing.T) {
+	testCases := []struct {
+		name     string
+		got      []int
+		expected []int
+	}{
+		{"from", Collect(From([]int{1, 2, 3})), []int{1, 2, 3}},
+		{"map", Collect(Map(From([]int{1, 2, 3}), func(v int, idx int) int { return v*10 + idx })), []int{10, 21, 32}},
+		{"filter", Collect(Filter(From([]int{1, 2, 3, 4}), func(v int) bool { return v%2 == 0 })), []int{2, 4}},
+		{"flatmap", Collect(FlatMap(From([]int{1, 2}), func(v int, _ int) iter.Seq[int] { return From([]int{v, v * 10}) })), []int{1, 10, 2, 20}},
+		{"empty", Collect(Map(From([]int{}), func(v int, _ int) int { return v })), []int{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if len(testCase.got) != len(testCase.expected) || !is.SameSlice(testCase.got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got)
+			}
+		})
+	}
+}
+
+func TestLazy_Enumerate(t *testing.T) {
+	var indices []int
+	var values []string
+	for i, v := range Enumerate(From([]string{"a", "b"})) {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !is.SameSlice(indices, []int{0, 1}) || !is.SameSlice(values, []string{"a", "b"}) {
+		t.Errorf("Expected [0 1] [a b], got %v %v", indices, values)
+	}
+}
+
+func TestLazy_Zip(t *testing.T) {
+	produced := 0
+	var left []string
+	var right []int
+	for l, r := range Zip(From([]string{"a", "b"}), naturals(&produced)) {
+		left = append(left, l)
+		right = append(right, r)
+	}
+	if !is.SameSlice(left, []string{"a", "b"}) || !is.SameSlice(right, []int{0, 1}) {
+		t.Errorf("Expected [a b] [0 1], got %v %v", left, right)
+	}
+	if produced > 3 {
+		t.Errorf("Expected at most 3 values to be produced, got %d", produced)
+	}
+}
+
+func TestLazy_Laziness(t *testing.T) {
+	produced := 0
+	calls := 0
+	seq := Map(Filter(naturals(&produced), func(v int) bool { return v%2 == 1 }), func(v int, _ int) int {
+		calls++
+		return v * v
+	})
+	got := Collect(Take(seq, 3))
+	if !is.SameSlice(got, []int{1, 9, 25}) {
+		t.Errorf("Expected [1 9 25], got %v", got)
+	}
+	if produced != 6 || calls != 3 {
+		t.Errorf("Expected 6 values produced and 3 calls, got %d and %d", produced, calls)
+	}
+}
@@ -0,0 +1,85 @@
+package lazy
+
+import "iter"
+
+// Take returns a sequence with at most the first n values.
+// Examples:
+//
+//	lazy.Take(lazy.From([]int{1, 2, 3}), 2) // yields 1, 2
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for value := range seq {
+			if !yield(value) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence without the first n values.
+// Examples:
+//
+//	lazy.Skip(lazy.From([]int{1, 2, 3}), 2) // yields 3
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for value := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns a sequence with the leading values that satisfy the predicate;
+// it stops at the first value that does not.
+// Examples:
+//
+//	lazy.TakeWhile(lazy.From([]int{1, 2, 5, 1}), func(v int) bool { return v < 3 }) // yields 1, 2
+func TakeWhile[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if !fn(value) || !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of slices of size values; the last one may be shorter.
+// Each chunk is a new slice. It panics if size is below 1.
+// Examples:
+//
+//	lazy.Chunk(lazy.From([]int{1, 2, 3}), 2) // yields [1 2], [3]
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("lazy.Chunk: size must be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
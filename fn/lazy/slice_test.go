@@ -0,0 +1,56 @@
+package lazy
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestLazy_Slice(t *testing.T) {
+	values := From([]int{1, 2, 3, 4, 5})
+	testCases := []struct {
+		name     string
+		got      []int
+		expected []int
+	}{
+		{"take", Collect(Take(values, 2)), []int{1, 2}},
+		{"take more", Collect(Take(values, 10)), []int{1, 2, 3, 4, 5}},
+		{"take zero", Collect(Take(values, 0)), []int{}},
+		{"skip", Collect(Skip(values, 3)), []int{4, 5}},
+		{"skip all", Collect(Skip(values, 9)), []int{}},
+		{"take while", Collect(TakeWhile(values, func(v int) bool { return v < 3 })), []int{1, 2}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if len(testCase.got) != len(testCase.expected) || !is.SameSlice(testCase.got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got)
+			}
+		})
+	}
+}
+
+func TestLazy_Chunk(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []int
+		size     int
+		expected [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+		{"empty", []int{}, 2, [][]int{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Collect(Chunk(From(testCase.value), testCase.size))
+			if len(got) != len(testCase.expected) {
+				tt.Fatalf("Expected %v, got %v", testCase.expected, got)
+			}
+			for i := range got {
+				if !is.SameSlice(got[i], testCase.expected[i]) {
+					tt.Errorf("Expected %v, got %v", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package fn
 
+import "errors"
+
 // Map applies a function to each element of a slice and returns a new slice.
 // Examples:
 //
@@ -23,3 +25,41 @@ func MapReverse[T any, U any](slice []T, fn func(T, int) U) []U {
 	}
 	return result
 }
+
+// MapErr applies a fallible function to each element of a slice and returns a new slice.
+// It stops at the first error and returns it as an *IndexError.
+// Examples:
+//
+//	fn.MapErr([]string{"1", "x"}, func(v string, _ int) (int, error) { return strconv.Atoi(v) }) // nil, index 1: ...
+func MapErr[T any, U any](slice []T, fn func(value T, idx int) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+	for i, value := range slice {
+		out, err := fn(value, i)
+		if err != nil {
+			return nil, &IndexError{i, err}
+		}
+		result[i] = out
+	}
+	return result, nil
+}
+
+// MapErrAll applies a fallible function to every element of a slice, whatever the errors.
+// Failed elements hold the zero value in the result and every error is returned as an
+// *IndexError joined with errors.Join.
+// Examples:
+//
+//	fn.MapErrAll([]string{"x", "2", "y"}, func(v string, _ int) (int, error) { return strconv.Atoi(v) })
+//	// [0 2 0], index 0: ...\nindex 2: ...
+func MapErrAll[T any, U any](slice []T, fn func(value T, idx int) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+	var errs []error
+	for i, value := range slice {
+		out, err := fn(value, i)
+		if err != nil {
+			errs = append(errs, &IndexError{i, err})
+			continue
+		}
+		result[i] = out
+	}
+	return result, errors.Join(errs...)
+}
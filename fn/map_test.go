@@ -1,6 +1,8 @@
 package fn
 
 import (
+	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/visual-pivert/go-starter/is"
@@ -47,3 +49,52 @@ func TestFn_MapReverse(t *testing.T) {
 		})
 	}
 }
+
+func TestFn_MapErr(t *testing.T) {
+	useCases := []struct {
+		name          string
+		value         []string
+		expected      []int
+		expectedIndex int
+	}{
+		{"all valid", []string{"1", "2"}, []int{1, 2}, -1},
+		{"first error", []string{"1", "x", "y"}, nil, 1},
+		{"empty", []string{}, []int{}, -1},
+	}
+
+	for _, useCase := range useCases {
+		t.Run(useCase.name, func(t *testing.T) {
+			got, err := MapErr(useCase.value, func(v string, _ int) (int, error) { return strconv.Atoi(v) })
+			if useCase.expectedIndex >= 0 {
+				var indexErr *IndexError
+				if !errors.As(err, &indexErr) || indexErr.Index != useCase.expectedIndex || got != nil {
+					t.Fatalf("Expected an IndexError at %d and no result, got %v %v", useCase.expectedIndex, got, err)
+				}
+				return
+			}
+			if err != nil || len(got) != len(useCase.expected) || !is.SameSlice(got, useCase.expected) {
+				t.Errorf("Expected %v, got %v (%v)", useCase.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestFn_MapErrAll(t *testing.T) {
+	got, err := MapErrAll([]string{"x", "2", "y"}, func(v string, _ int) (int, error) { return strconv.Atoi(v) })
+	if !is.SameSlice(got, []int{0, 2, 0}) {
+		t.Errorf("Expected [0 2 0], got %v", got)
+	}
+	var indices []int
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var indexErr *IndexError
+		if errors.As(e, &indexErr) {
+			indices = append(indices, indexErr.Index)
+		}
+	}
+	if !is.SameSlice(indices, []int{0, 2}) || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected errors at [0 2], got %v", err)
+	}
+	if _, err := MapErrAll([]string{"1"}, func(v string, _ int) (int, error) { return strconv.Atoi(v) }); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+package fn
+
+import "cmp"
+
+// MinBy returns the element with the smallest key returned by fn (the first one on ties)
+// and false if the slice is empty.
+// Examples:
+//
+//	fn.MinBy([]string{"ccc", "a", "bb"}, func(v string, _ int) int { return len(v) }) // a, true
+func MinBy[T any, K cmp.Ordered](slice []T, fn func(value T, idx int) K) (T, bool) {
+	return extremeBy(slice, fn, -1)
+}
+
+// MaxBy returns the element with the largest key returned by fn (the first one on ties)
+// and false if the slice is empty.
+// Examples:
+//
+//	fn.MaxBy([]string{"ccc", "a", "bb"}, func(v string, _ int) int { return len(v) }) // ccc, true
+func MaxBy[T any, K cmp.Ordered](slice []T, fn func(value T, idx int) K) (T, bool) {
+	return extremeBy(slice, fn, 1)
+}
+
+// extremeBy returns the first element whose key compares to every other key with the
+// given sign (-1 for the minimum, +1 for the maximum) or is equal.
+func extremeBy[T any, K cmp.Ordered](slice []T, fn func(value T, idx int) K, sign int) (T, bool) {
+	var best T
+	if len(slice) == 0 {
+		return best, false
+	}
+	best = slice[0]
+	bestKey := fn(slice[0], 0)
+	for i := 1; i < len(slice); i++ {
+		key := fn(slice[i], i)
+		if cmp.Compare(key, bestKey) == sign {
+			best, bestKey = slice[i], key
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,29 @@
+package fn
+
+import "testing"
+
+func TestFn_MinMaxBy(t *testing.T) {
+	length := func(v string, _ int) int { return len(v) }
+	testCases := []struct {
+		name       string
+		fn         func([]string, func(string, int) int) (string, bool)
+		value      []string
+		expected   string
+		expectedOk bool
+	}{
+		{"min", MinBy[string, int], []string{"ccc", "a", "bb"}, "a", true},
+		{"min first on ties", MinBy[string, int], []string{"bb", "a", "c"}, "a", true},
+		{"max", MaxBy[string, int], []string{"ccc", "a", "bb"}, "ccc", true},
+		{"max first on ties", MaxBy[string, int], []string{"a", "bb", "cc"}, "bb", true},
+		{"empty", MaxBy[string, int], []string{}, "", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, ok := testCase.fn(testCase.value, length)
+			if got != testCase.expected || ok != testCase.expectedOk {
+				tt.Errorf("Expected %v %v, got %v %v", testCase.expected, testCase.expectedOk, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap applies fn to each element of a slice using at most limit goroutines
+// (limit <= 0 means runtime.GOMAXPROCS(0)) and returns the results in input order.
+// On the first error the context passed to the callbacks is cancelled, no new element
+// is started and the error is returned as an *IndexError (the one with the lowest index
+// when several callbacks fail). If ctx is cancelled, ctx.Err() is returned.
+// Examples:
+//
+//	out, err := fn.ParallelMap(ctx, []string{"1", "2"}, 4, func(_ context.Context, v string, _ int) (int, error) {
+//		return strconv.Atoi(v)
+//	}) // [1, 2], nil
+func ParallelMap[T any, U any](ctx context.Context, slice []T, limit int, fn func(ctx context.Context, value T, idx int) (U, error)) ([]U, error) {
+	out := make([]U, len(slice))
+	err := parallelDo(ctx, len(slice), limit, func(ctx context.Context, i int) error {
+		value, err := fn(ctx, slice[i], i)
+		if err != nil {
+			return &IndexError{i, err}
+		}
+		out[i] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParallelFilter returns the elements of a slice for which fn returns true, in input
+// order. The predicates run concurrently as in ParallelMap, with the same error handling.
+// Examples:
+//
+//	out, err := fn.ParallelFilter(ctx, []int{1, 2, 3}, 2, func(_ context.Context, v int, _ int) (bool, error) {
+//		return v > 1, nil
+//	}) // [2, 3], nil
+func ParallelFilter[T any](ctx context.Context, slice []T, limit int, fn func(ctx context.Context, value T, idx int) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, slice, limit, fn)
+	if err != nil {
+		return nil, err
+	}
+	var out []T
+	for i, value := range slice {
+		if keep[i] {
+			out = append(out, value)
+		}
+	}
+	return out, nil
+}
+
+// ParallelReduce folds a slice with an associative function: the slice is split into
+// at most limit contiguous chunks that are folded concurrently, then the partial results
+// are combined in order, starting from initialValue. fn must be associative and
+// initialValue must be its identity (0 for a sum, 1 for a product) for the result to
+// match a sequential fold. Errors are reported as in ParallelMap; an empty slice
+// returns initialValue.
+// Examples:
+//
+//	sum, err := fn.ParallelReduce(ctx, []int{1, 2, 3, 4}, 2, 0, func(a int, b int) (int, error) {
+//		return a + b, nil
+//	}) // 10, nil
+func ParallelReduce[T any](ctx context.Context, slice []T, limit int, initialValue T, fn func(acc T, value T) (T, error)) (T, error) {
+	if len(slice) == 0 {
+		return initialValue, ctx.Err()
+	}
+	chunks := min(workers(limit), len(slice))
+	size := (len(slice) + chunks - 1) / chunks
+	starts := make([]int, 0, chunks)
+	for start := 0; start < len(slice); start += size {
+		starts = append(starts, start)
+	}
+	partials := make([]T, len(starts))
+	err := parallelDo(ctx, len(starts), limit, func(ctx context.Context, c int) error {
+		end := min(starts[c]+size, len(slice))
+		acc := slice[starts[c]]
+		for i := starts[c] + 1; i < end; i++ {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var err error
+			if acc, err = fn(acc, slice[i]); err != nil {
+				return &IndexError{i, err}
+			}
+		}
+		partials[c] = acc
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	acc := initialValue
+	for c, partial := range partials {
+		if acc, err = fn(acc, partial); err != nil {
+			var zero T
+			return zero, &IndexError{starts[c], err}
+		}
+	}
+	return acc, nil
+}
+
+// workers returns the number of goroutines to use for a concurrency limit.
+func workers(limit int) int {
+	if limit <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return limit
+}
+
+// parallelDo runs task for every index in [0, n) with at most limit goroutines. It stops
+// starting new tasks after the first failure and returns the error of the failed task
+// with the lowest index, or ctx.Err() if ctx was cancelled. Errors returned by tasks that
+// stopped because of the cancellation are ignored.
+func parallelDo(ctx context.Context, n int, limit int, task func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		next     atomic.Int64
+		mu       sync.Mutex
+		firstErr error
+		errIndex = n
+		wg       sync.WaitGroup
+	)
+	for range min(workers(limit), n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n || ctx.Err() != nil {
+					return
+				}
+				if err := task(ctx, i); err != nil {
+					if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+						// the callback gave up because another one failed or ctx was cancelled
+						return
+					}
+					mu.Lock()
+					if i < errIndex {
+						firstErr, errIndex = err, i
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,152 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_ParallelMap(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         []string
+		limit         int
+		expected      []int
+		expectedIndex int
+	}{
+		{"ordered", []string{"1", "2", "3", "4", "5"}, 2, []int{1, 2, 3, 4, 5}, -1},
+		{"default limit", []string{"7", "8"}, 0, []int{7, 8}, -1},
+		{"empty", []string{}, 3, []int{}, -1},
+		{"lowest failing index", []string{"1", "x", "3", "y"}, 4, nil, 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := ParallelMap(context.Background(), testCase.value, testCase.limit, func(_ context.Context, v string, _ int) (int, error) {
+				return strconv.Atoi(v)
+			})
+			if testCase.expectedIndex >= 0 {
+				var indexErr *IndexError
+				if !errors.As(err, &indexErr) || indexErr.Index != testCase.expectedIndex {
+					tt.Fatalf("Expected an IndexError at %d, got %v", testCase.expectedIndex, err)
+				}
+				if !errors.Is(err, strconv.ErrSyntax) {
+					tt.Errorf("Expected the callback error to be wrapped, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_ParallelMapLimit(t *testing.T) {
+	const limit = 3
+	var running, peak atomic.Int64
+	var once sync.Once
+	// the first calls block until limit of them run together, so that the peak is reached
+	release := make(chan struct{})
+	values := make([]int, 50)
+	_, err := ParallelMap(context.Background(), values, limit, func(_ context.Context, v int, _ int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		if n == limit {
+			once.Do(func() { close(release) })
+		}
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+			return 0, errors.New("the limit was never reached")
+		}
+		return v, nil
+	})
+	if err != nil || peak.Load() != limit {
+		t.Errorf("Expected %d concurrent calls at most and at peak, got %d (%v)", limit, peak.Load(), err)
+	}
+}
+
+func TestFn_ParallelMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls atomic.Int64
+	_, err := ParallelMap(ctx, []int{1, 2, 3}, 1, func(_ context.Context, v int, _ int) (int, error) {
+		calls.Add(1)
+		return v, nil
+	})
+	if !errors.Is(err, context.Canceled) || calls.Load() != 0 {
+		t.Errorf("Expected context.Canceled and no call, got %v after %d calls", err, calls.Load())
+	}
+}
+
+func TestFn_ParallelFilter(t *testing.T) {
+	got, err := ParallelFilter(context.Background(), []int{1, 2, 3, 4, 5, 6}, 3, func(_ context.Context, v int, _ int) (bool, error) {
+		return v%2 == 0, nil
+	})
+	if err != nil || !is.SameSlice(got, []int{2, 4, 6}) {
+		t.Errorf("Expected [2 4 6], got %v (%v)", got, err)
+	}
+	_, err = ParallelFilter(context.Background(), []int{1, 2}, 2, func(_ context.Context, v int, _ int) (bool, error) {
+		return false, fmt.Errorf("boom")
+	})
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 0 {
+		t.Errorf("Expected an IndexError at 0, got %v", err)
+	}
+}
+
+func TestFn_ParallelReduce(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []string
+		limit    int
+		initial  string
+		expected string
+	}{
+		{"concat keeps order", []string{"a", "b", "c", "d", "e"}, 2, "", "abcde"},
+		{"more workers than values", []string{"a", "b"}, 8, ">", ">ab"},
+		{"single worker", []string{"a", "b", "c"}, 1, "", "abc"},
+		{"empty", []string{}, 4, "init", "init"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := ParallelReduce(context.Background(), testCase.value, testCase.limit, testCase.initial, func(a string, b string) (string, error) {
+				return a + b, nil
+			})
+			if err != nil || got != testCase.expected {
+				tt.Errorf("Expected %v, got %v (%v)", testCase.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestFn_ParallelReduceError(t *testing.T) {
+	_, err := ParallelReduce(context.Background(), []int{1, 2, 3, 4, 5, 6}, 2, 0, func(a int, b int) (int, error) {
+		if b == 5 {
+			return 0, fmt.Errorf("unexpected %d", b)
+		}
+		return a + b, nil
+	})
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 4 {
+		t.Errorf("Expected an IndexError at 4, got %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package fn
+
+// Partition splits a slice into the elements that satisfy the predicate and those that
+// do not, keeping their order.
+// Examples:
+//
+//	fn.Partition([]int{1, 2, 3}, func(v int, _ int) bool { return v > 1 }) // [2 3], [1]
+func Partition[T any](slice []T, fn func(value T, idx int) bool) ([]T, []T) {
+	var in, out []T
+	for i, value := range slice {
+		if fn(value, i) {
+			in = append(in, value)
+		} else {
+			out = append(out, value)
+		}
+	}
+	return in, out
+}
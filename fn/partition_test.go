@@ -0,0 +1,30 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Partition(t *testing.T) {
+	testCases := []struct {
+		name        string
+		value       []int
+		fn          func(int, int) bool
+		expectedIn  []int
+		expectedOut []int
+	}{
+		{"greater than 1", []int{1, 2, 3}, func(v int, _ int) bool { return v > 1 }, []int{2, 3}, []int{1}},
+		{"even index", []int{5, 6, 7}, func(_ int, idx int) bool { return idx%2 == 0 }, []int{5, 7}, []int{6}},
+		{"none", []int{1}, func(v int, _ int) bool { return false }, []int{}, []int{1}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			in, out := Partition(testCase.value, testCase.fn)
+			if len(in) != len(testCase.expectedIn) || !is.SameSlice(in, testCase.expectedIn) || !is.SameSlice(out, testCase.expectedOut) {
+				tt.Errorf("Expected %v %v, got %v %v", testCase.expectedIn, testCase.expectedOut, in, out)
+			}
+		})
+	}
+}
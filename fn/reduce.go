@@ -1,16 +1,35 @@
 package fn
 
-// Reduce returns a new slice with cumulative results.
+// Reduce returns a new slice with cumulative results. An empty slice gives an empty
+// result; see Scan for an accumulator of another type.
 // Examples:
 //
 //	fn.Reduce([]int{1,2,3}, 0, func(cum int, value int, index int) int {
 //		return cum + value
 //	}) // [1, 3, 6] (v[len(v)-1] = sum of 1,2,3)
 func Reduce[T any](slice []T, initialValue T, fn func(cum T, value T, index int) T) []T {
+	return Scan(slice, initialValue, fn)
+}
+
+// ReduceErr returns a new slice with cumulative results of a fallible function.
+// It stops at the first error and returns it as an *IndexError.
+// Examples:
+//
+//	fn.ReduceErr([]int{1, 2, 3}, 0, func(cum int, value int, _ int) (int, error) {
+//		if value < 0 {
+//			return 0, errors.New("negative value")
+//		}
+//		return cum + value, nil
+//	}) // [1, 3, 6], nil
+func ReduceErr[T any](slice []T, initialValue T, fn func(cum T, value T, index int) (T, error)) ([]T, error) {
 	out := make([]T, len(slice))
-	out[0] = fn(initialValue, slice[0], 0)
-	for i := 1; i < len(slice); i++ {
-		out[i] = fn(out[i-1], slice[i], i)
+	cum := initialValue
+	for i, value := range slice {
+		var err error
+		if cum, err = fn(cum, value, i); err != nil {
+			return nil, &IndexError{i, err}
+		}
+		out[i] = cum
 	}
-	return out
+	return out, nil
 }
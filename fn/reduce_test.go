@@ -1,6 +1,7 @@
 package fn
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/visual-pivert/go-starter/is"
@@ -18,14 +19,33 @@ func TestFn_Reduce(t *testing.T) {
 		{"cummultiply", 1, []any{1, 2, 3}, func(a, b any, i int) any { return a.(int) * b.(int) }, []any{1, 2, 6}},
 		{"cumconcat", "", []any{"a", "b", "c"}, func(a, b any, i int) any { return a.(string) + b.(string) }, []any{"a", "ab", "abc"}},
 		{"cumsum index", 0, []any{2, 3, 4}, func(a, b any, i int) any { return a.(int) + i }, []any{0, 1, 3}},
+		{"empty", 0, []any{}, func(a, b any, i int) any { return a.(int) + b.(int) }, []any{}},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(tt *testing.T) {
 			got := Reduce(testCase.value, testCase.initialValue, testCase.fn)
-			if is.SameSlice(got, testCase.expected) == false {
+			if len(got) != len(testCase.expected) || is.SameSlice(got, testCase.expected) == false {
 				tt.Errorf("Expected %v, got %v", testCase.expected, got)
 			}
 		})
 	}
 }
+
+func TestFn_ReduceErr(t *testing.T) {
+	positiveSum := func(cum int, value int, _ int) (int, error) {
+		if value < 0 {
+			return 0, errors.New("negative value")
+		}
+		return cum + value, nil
+	}
+	got, err := ReduceErr([]int{1, 2, 3}, 0, positiveSum)
+	if err != nil || !is.SameSlice(got, []int{1, 3, 6}) {
+		t.Errorf("Expected [1 3 6], got %v (%v)", got, err)
+	}
+	_, err = ReduceErr([]int{1, -2, 3}, 0, positiveSum)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 || err.Error() != "index 1: negative value" {
+		t.Errorf("Expected an IndexError at 1, got %v", err)
+	}
+}
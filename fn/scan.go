@@ -0,0 +1,18 @@
+package fn
+
+// Scan returns a new slice with the successive values of an accumulator, whose type
+// may differ from the element type. An empty slice gives an empty result.
+// Examples:
+//
+//	fn.Scan([]string{"a", "bb", "ccc"}, 0, func(acc int, value string, index int) int {
+//		return acc + len(value)
+//	}) // [1, 3, 6]
+func Scan[T any, U any](slice []T, initialValue U, fn func(acc U, value T, index int) U) []U {
+	out := make([]U, len(slice))
+	acc := initialValue
+	for i, value := range slice {
+		acc = fn(acc, value, i)
+		out[i] = acc
+	}
+	return out
+}
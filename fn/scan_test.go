@@ -0,0 +1,29 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Scan(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []string
+		fn       func(int, string, int) int
+		expected []int
+	}{
+		{"total length", []string{"a", "bb", "ccc"}, func(acc int, v string, _ int) int { return acc + len(v) }, []int{1, 3, 6}},
+		{"index", []string{"a", "b"}, func(acc int, _ string, i int) int { return acc + i }, []int{0, 1}},
+		{"empty", []string{}, func(acc int, v string, _ int) int { return acc + len(v) }, []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Scan(testCase.value, 0, testCase.fn)
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package fn
+
+// Intersect returns the distinct elements present in both slices, in the order of the
+// first slice.
+// Examples:
+//
+//	fn.Intersect([]int{1, 2, 3, 2}, []int{2, 3, 4}) // [2 3]
+func Intersect[T comparable](first []T, second []T) []T {
+	in := toSet(second)
+	return Uniq(Filter(first, func(value T) bool {
+		_, ok := in[value]
+		return ok
+	}))
+}
+
+// Union returns the distinct elements of both slices, in order of first appearance.
+// Examples:
+//
+//	fn.Union([]int{1, 2}, []int{2, 3}) // [1 2 3]
+func Union[T comparable](first []T, second []T) []T {
+	return Uniq(append(append([]T(nil), first...), second...))
+}
+
+// Difference returns the distinct elements of the first slice that are not in the second.
+// Examples:
+//
+//	fn.Difference([]int{1, 2, 3}, []int{2}) // [1 3]
+func Difference[T comparable](first []T, second []T) []T {
+	in := toSet(second)
+	return Uniq(Filter(first, func(value T) bool {
+		_, ok := in[value]
+		return !ok
+	}))
+}
+
+// toSet returns the elements of a slice as a set.
+func toSet[T comparable](slice []T) map[T]struct{} {
+	out := make(map[T]struct{}, len(slice))
+	for _, value := range slice {
+		out[value] = struct{}{}
+	}
+	return out
+}
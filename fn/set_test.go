@@ -0,0 +1,32 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Set(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fn       func([]int, []int) []int
+		first    []int
+		second   []int
+		expected []int
+	}{
+		{"intersect", Intersect[int], []int{1, 2, 3, 2}, []int{2, 3, 4}, []int{2, 3}},
+		{"intersect disjoint", Intersect[int], []int{1}, []int{2}, []int{}},
+		{"union", Union[int], []int{1, 2, 1}, []int{2, 3}, []int{1, 2, 3}},
+		{"difference", Difference[int], []int{1, 2, 3, 1}, []int{2}, []int{1, 3}},
+		{"difference empty second", Difference[int], []int{1}, []int{}, []int{1}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := testCase.fn(testCase.first, testCase.second)
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
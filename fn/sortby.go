@@ -0,0 +1,20 @@
+package fn
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortBy returns a new slice sorted in ascending order of the key returned by fn. The
+// sort is stable: elements with equal keys keep their order. fn is called once per element.
+// Examples:
+//
+//	fn.SortBy([]string{"ccc", "a", "bb"}, func(v string, _ int) int { return len(v) }) // [a bb ccc]
+func SortBy[T any, K cmp.Ordered](slice []T, fn func(value T, idx int) K) []T {
+	keyed := Zip(Map(slice, fn), slice)
+	slices.SortStableFunc(keyed, func(a Pair[K, T], b Pair[K, T]) int {
+		return cmp.Compare(a.First, b.First)
+	})
+	_, out := Unzip(keyed)
+	return out
+}
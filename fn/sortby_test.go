@@ -0,0 +1,32 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_SortBy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []string
+		expected []string
+	}{
+		{"by length", []string{"ccc", "a", "bb"}, []string{"a", "bb", "ccc"}},
+		{"stable", []string{"b", "aa", "a", "c"}, []string{"b", "a", "c", "aa"}},
+		{"empty", []string{}, []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			input := append([]string(nil), testCase.value...)
+			got := SortBy(input, func(v string, _ int) int { return len(v) })
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+			if !is.SameSlice(input, testCase.value) {
+				tt.Errorf("Expected the input to be left unchanged, got %v", input)
+			}
+		})
+	}
+}
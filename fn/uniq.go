@@ -0,0 +1,28 @@
+package fn
+
+// Uniq returns the distinct elements of a slice in order of first appearance.
+// Examples:
+//
+//	fn.Uniq([]int{1, 2, 1, 3, 2}) // [1 2 3]
+func Uniq[T comparable](slice []T) []T {
+	return UniqBy(slice, func(value T, _ int) T { return value })
+}
+
+// UniqBy returns the elements of a slice whose key, returned by fn, has not been seen
+// before, in order of first appearance.
+// Examples:
+//
+//	fn.UniqBy([]string{"a", "bb", "c"}, func(v string, _ int) int { return len(v) }) // [a bb]
+func UniqBy[T any, K comparable](slice []T, fn func(value T, idx int) K) []T {
+	seen := make(map[K]struct{}, len(slice))
+	out := []T{}
+	for i, value := range slice {
+		key := fn(value, i)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, value)
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Uniq(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    []int
+		expected []int
+	}{
+		{"duplicates", []int{1, 2, 1, 3, 2}, []int{1, 2, 3}},
+		{"empty", []int{}, []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Uniq(testCase.value)
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_UniqBy(t *testing.T) {
+	got := UniqBy([]string{"a", "bb", "c", "dd", "eee"}, func(v string, _ int) int { return len(v) })
+	if !is.SameSlice(got, []string{"a", "bb", "eee"}) {
+		t.Errorf("Expected [a bb eee], got %v", got)
+	}
+}
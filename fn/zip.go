@@ -0,0 +1,33 @@
+package fn
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs the elements of two slices by position; the result has the length of the
+// shorter slice.
+// Examples:
+//
+//	fn.Zip([]string{"a", "b"}, []int{1, 2, 3}) // [{a 1} {b 2}]
+func Zip[A any, B any](first []A, second []B) []Pair[A, B] {
+	out := make([]Pair[A, B], min(len(first), len(second)))
+	for i := range out {
+		out[i] = Pair[A, B]{first[i], second[i]}
+	}
+	return out
+}
+
+// Unzip splits a slice of pairs into two slices.
+// Examples:
+//
+//	fn.Unzip([]fn.Pair[string, int]{{"a", 1}, {"b", 2}}) // [a b], [1 2]
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	first := make([]A, len(pairs))
+	second := make([]B, len(pairs))
+	for i, pair := range pairs {
+		first[i], second[i] = pair.First, pair.Second
+	}
+	return first, second
+}
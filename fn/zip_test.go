@@ -0,0 +1,37 @@
+package fn
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFn_Zip(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    []string
+		second   []int
+		expected []Pair[string, int]
+	}{
+		{"same length", []string{"a", "b"}, []int{1, 2}, []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{"shorter first", []string{"a"}, []int{1, 2}, []Pair[string, int]{{"a", 1}}},
+		{"empty", []string{}, []int{1}, []Pair[string, int]{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Zip(testCase.first, testCase.second)
+			if !reflect.DeepEqual(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFn_Unzip(t *testing.T) {
+	first, second := Unzip([]Pair[string, int]{{"a", 1}, {"b", 2}})
+	if !is.SameSlice(first, []string{"a", "b"}) || !is.SameSlice(second, []int{1, 2}) {
+		t.Errorf("Expected [a b] [1 2], got %v %v", first, second)
+	}
+}
@@ -0,0 +1,6 @@
+// Package arrow reads and writes flat tables in the Arrow IPC file and stream formats with
+// the standard library only. It implements the parts of the format needed by the extract
+// and load packages: the flatbuffers metadata, record batches and dictionary batches of
+// primitive, string, date, timestamp and decimal arrays. Values are exchanged as the
+// canonical Go values of the series type tags.
+package arrow
@@ -0,0 +1,327 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"errors"
+	"slices"
+)
+
+// Flatbuffers encoding (https://flatbuffers.dev/internals/), limited to what the Arrow IPC
+// metadata needs: tables, unions, strings, vectors of tables or strings and vectors of structs.
+
+// fbTable is a table to encode: a list of fields identified by their slot in the schema.
+type fbTable []fbField
+
+// fbField is a field of a table. Its value is an int8, uint8, bool, int16, int32 or int64
+// scalar, a string, a nested fbTable, a []fbTable, a []string or fbStructs.
+type fbField struct {
+	slot  int
+	value any
+}
+
+// fbStructs is a vector of structs, already encoded, whose elements are 8-byte aligned.
+type fbStructs struct {
+	count int
+	data  []byte
+}
+
+// fbBuilder encodes a flatbuffer front to back: a table is written before the objects it
+// refers to, whose offsets are patched once they are written, so that every offset points
+// forward as the format requires.
+type fbBuilder struct {
+	buf []byte
+}
+
+// encodeFlatbuffer encodes a root table, padded to a multiple of 8 bytes.
+func encodeFlatbuffer(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	pos := b.table(root)
+	binary.LittleEndian.PutUint32(b.buf, uint32(pos))
+	b.align(8, 0)
+	return b.buf
+}
+
+// align pads the buffer with zeros until its length plus extra is a multiple of n.
+func (b *fbBuilder) align(n int, extra int) {
+	for (len(b.buf)+extra)%n != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+// fieldSize returns the inline size of a field value; references take 4 bytes.
+func fieldSize(v any) int {
+	switch v.(type) {
+	case int8, uint8, bool:
+		return 1
+	case int16:
+		return 2
+	case int64:
+		return 8
+	}
+	return 4
+}
+
+func (b *fbBuilder) table(t fbTable) int {
+	// lay out the fields after the vtable offset, largest first to keep them aligned
+	fields := slices.Clone(t)
+	slices.SortStableFunc(fields, func(x, y fbField) int { return fieldSize(y.value) - fieldSize(x.value) })
+	offsets := make([]int, len(fields))
+	size := 4
+	slots := 0
+	for i, f := range fields {
+		n := fieldSize(f.value)
+		size = (size + n - 1) / n * n
+		offsets[i] = size
+		size += n
+		slots = max(slots, f.slot+1)
+	}
+
+	b.align(2, 0)
+	vtable := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4+2*slots)...)
+	b.align(8, 0)
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint16(b.buf[vtable:], uint16(4+2*slots))
+	binary.LittleEndian.PutUint16(b.buf[vtable+2:], uint16(size))
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(pos-vtable))
+
+	for i, f := range fields {
+		binary.LittleEndian.PutUint16(b.buf[vtable+4+2*f.slot:], uint16(offsets[i]))
+		at := b.buf[pos+offsets[i]:]
+		switch v := f.value.(type) {
+		case int8:
+			at[0] = byte(v)
+		case uint8:
+			at[0] = v
+		case bool:
+			if v {
+				at[0] = 1
+			}
+		case int16:
+			binary.LittleEndian.PutUint16(at, uint16(v))
+		case int32:
+			binary.LittleEndian.PutUint32(at, uint32(v))
+		case int64:
+			binary.LittleEndian.PutUint64(at, uint64(v))
+		}
+	}
+	for i, f := range fields {
+		if child := b.object(f.value); child >= 0 {
+			b.patch(pos+offsets[i], child)
+		}
+	}
+	return pos
+}
+
+// patch stores at position at the offset of the object written at target.
+func (b *fbBuilder) patch(at int, target int) {
+	binary.LittleEndian.PutUint32(b.buf[at:], uint32(target-at))
+}
+
+// object writes a referenced value and returns its position, or -1 for scalars.
+func (b *fbBuilder) object(v any) int {
+	switch v := v.(type) {
+	case string:
+		b.align(4, 0)
+		pos := len(b.buf)
+		b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(v)))
+		b.buf = append(append(b.buf, v...), 0)
+		return pos
+	case fbTable:
+		return b.table(v)
+	case []fbTable:
+		pos := b.vector(len(v))
+		for i, t := range v {
+			b.patch(pos+4+4*i, b.table(t))
+		}
+		return pos
+	case []string:
+		pos := b.vector(len(v))
+		for i, s := range v {
+			b.patch(pos+4+4*i, b.object(s))
+		}
+		return pos
+	case fbStructs:
+		b.align(8, 4)
+		pos := len(b.buf)
+		b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(v.count))
+		b.buf = append(b.buf, v.data...)
+		return pos
+	}
+	return -1
+}
+
+// vector writes the length and room for the offsets of a vector of references.
+func (b *fbBuilder) vector(n int) int {
+	b.align(4, 0)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(n))
+	b.buf = append(b.buf, make([]byte, 4*n)...)
+	return pos
+}
+
+var errMalformed = errors.New("malformed flatbuffer")
+
+// fbReader gives access to the tables of a flatbuffer. Accessors panic with errMalformed on
+// out of range offsets; decoding functions recover it with recoverMalformed.
+type fbReader struct {
+	buf []byte
+	pos int // position of the table
+}
+
+// recoverMalformed turns a panic caused by malformed data into an error.
+func recoverMalformed(err *error) {
+	if r := recover(); r != nil {
+		if r != errMalformed {
+			panic(r)
+		}
+		*err = errMalformed
+	}
+}
+
+// rootTable returns the root table of a flatbuffer.
+func rootTable(buf []byte) fbReader {
+	r := fbReader{buf: buf}
+	return fbReader{buf: buf, pos: r.uoffset(0)}
+}
+
+func (r fbReader) check(pos int, n int) {
+	if pos < 0 || n < 0 || pos > len(r.buf)-n {
+		panic(errMalformed)
+	}
+}
+
+func (r fbReader) u32(pos int) int {
+	r.check(pos, 4)
+	return int(binary.LittleEndian.Uint32(r.buf[pos:]))
+}
+
+// uoffset follows the offset stored at pos.
+func (r fbReader) uoffset(pos int) int {
+	return pos + r.u32(pos)
+}
+
+// field returns the position of a field of the table, or 0 when it is absent.
+func (r fbReader) field(slot int) int {
+	r.check(r.pos, 4)
+	vtable := r.pos - int(int32(binary.LittleEndian.Uint32(r.buf[r.pos:])))
+	r.check(vtable, 4)
+	vtSize := int(binary.LittleEndian.Uint16(r.buf[vtable:]))
+	at := 4 + 2*slot
+	if at+2 > vtSize {
+		return 0
+	}
+	r.check(vtable+at, 2)
+	off := int(binary.LittleEndian.Uint16(r.buf[vtable+at:]))
+	if off == 0 {
+		return 0
+	}
+	return r.pos + off
+}
+
+func (r fbReader) scalar(slot int, size int, def uint64) uint64 {
+	pos := r.field(slot)
+	if pos == 0 {
+		return def
+	}
+	r.check(pos, size)
+	switch size {
+	case 1:
+		return uint64(r.buf[pos])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(r.buf[pos:]))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(r.buf[pos:]))
+	}
+	return binary.LittleEndian.Uint64(r.buf[pos:])
+}
+
+func (r fbReader) uint8(slot int, def uint8) uint8 {
+	return uint8(r.scalar(slot, 1, uint64(def)))
+}
+
+func (r fbReader) bool(slot int, def bool) bool {
+	d := uint64(0)
+	if def {
+		d = 1
+	}
+	return r.scalar(slot, 1, d) != 0
+}
+
+func (r fbReader) int16(slot int, def int16) int16 {
+	return int16(r.scalar(slot, 2, uint64(uint16(def))))
+}
+
+func (r fbReader) int32(slot int, def int32) int32 {
+	return int32(r.scalar(slot, 4, uint64(uint32(def))))
+}
+
+func (r fbReader) int64(slot int, def int64) int64 {
+	return int64(r.scalar(slot, 8, uint64(def)))
+}
+
+// table returns a nested table and whether it is present.
+func (r fbReader) table(slot int) (fbReader, bool) {
+	pos := r.field(slot)
+	if pos == 0 {
+		return fbReader{}, false
+	}
+	return fbReader{buf: r.buf, pos: r.uoffset(pos)}, true
+}
+
+func (r fbReader) string(slot int) string {
+	pos := r.field(slot)
+	if pos == 0 {
+		return ""
+	}
+	start := r.uoffset(pos)
+	n := r.u32(start)
+	r.check(start+4, n)
+	return string(r.buf[start+4 : start+4+n])
+}
+
+// vector returns the position of the first element and the length of a vector, with
+// elements of the given size.
+func (r fbReader) vector(slot int, size int) (int, int) {
+	pos := r.field(slot)
+	if pos == 0 {
+		return 0, 0
+	}
+	start := r.uoffset(pos)
+	n := r.u32(start)
+	if n > len(r.buf)/size {
+		panic(errMalformed)
+	}
+	r.check(start+4, n*size)
+	return start + 4, n
+}
+
+// tables returns the tables of a vector of tables.
+func (r fbReader) tables(slot int) []fbReader {
+	start, n := r.vector(slot, 4)
+	out := make([]fbReader, n)
+	for i := range out {
+		out[i] = fbReader{buf: r.buf, pos: r.uoffset(start + 4*i)}
+	}
+	return out
+}
+
+// structs returns the encoded elements of a vector of structs of the given size.
+func (r fbReader) structs(slot int, size int) [][]byte {
+	start, n := r.vector(slot, size)
+	out := make([][]byte, n)
+	for i := range out {
+		out[i] = r.buf[start+size*i : start+size*(i+1)]
+	}
+	return out
+}
+
+// keyValues decodes a vector of KeyValue tables into a map.
+func (r fbReader) keyValues(slot int) map[string]string {
+	out := map[string]string{}
+	for _, kv := range r.tables(slot) {
+		out[kv.string(0)] = kv.string(1)
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestFlatbufferRoundTrip(t *testing.T) {
+	structs := binary.LittleEndian.AppendUint64(binary.LittleEndian.AppendUint64(nil, 7), 9)
+	buf := encodeFlatbuffer(fbTable{
+		{0, int16(-3)},
+		{1, uint8(5)},
+		{2, "name"},
+		{3, fbTable{{0, int32(64)}, {1, true}}},
+		{5, int64(1 << 40)},
+		{6, []fbTable{{{0, "k1"}, {1, "v1"}}, {{0, "k2"}, {1, "v2"}}}},
+		{7, fbStructs{count: 2, data: structs}},
+		{8, fbTable{}},
+	})
+	if len(buf)%8 != 0 {
+		t.Errorf("expected a size multiple of 8, got %d", len(buf))
+	}
+	r := rootTable(buf)
+	if r.int16(0, 0) != -3 || r.uint8(1, 0) != 5 || r.string(2) != "name" || r.int64(5, 0) != 1<<40 {
+		t.Errorf("unexpected scalars %d %d %q %d", r.int16(0, 0), r.uint8(1, 0), r.string(2), r.int64(5, 0))
+	}
+	if r.int32(4, 42) != 42 || r.bool(9, true) != true || r.string(4) != "" {
+		t.Errorf("absent fields must give their default")
+	}
+	child, ok := r.table(3)
+	if !ok || child.int32(0, 0) != 64 || !child.bool(1, false) {
+		t.Errorf("unexpected child table")
+	}
+	if _, ok := r.table(4); ok {
+		t.Errorf("expected an absent table")
+	}
+	if _, ok := r.table(8); !ok {
+		t.Errorf("expected an empty table")
+	}
+	if kv := r.keyValues(6); len(kv) != 2 || kv["k1"] != "v1" || kv["k2"] != "v2" {
+		t.Errorf("unexpected key values %v", kv)
+	}
+	got := r.structs(7, 8)
+	if len(got) != 2 || binary.LittleEndian.Uint64(got[0]) != 7 || binary.LittleEndian.Uint64(got[1]) != 9 {
+		t.Errorf("unexpected structs %v", got)
+	}
+	start, _ := r.vector(7, 8)
+	if start%8 != 0 {
+		t.Errorf("expected structs aligned to 8, got %d", start)
+	}
+}
+
+func TestFbReader_malformed(t *testing.T) {
+	testCases := []struct {
+		name string
+		buf  []byte
+	}{
+		{"empty", nil},
+		{"root out of range", []byte{100, 0, 0, 0}},
+		{"vtable out of range", []byte{4, 0, 0, 0, 0, 1, 0, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := func() (err error) {
+				defer recoverMalformed(&err)
+				rootTable(tc.buf).string(0)
+				return nil
+			}()
+			if !is.Equal(err, errMalformed) {
+				tt.Errorf("expected %v, got %v", errMalformed, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Members of the Type union of Schema.fbs.
+const (
+	typeNull          = 1
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeBinary        = 4
+	typeUtf8          = 5
+	typeBool          = 6
+	typeDecimal       = 7
+	typeDate          = 8
+	typeTimestamp     = 10
+	typeLargeBinary   = 19
+	typeLargeUtf8     = 20
+)
+
+// Members of the MessageHeader union of Message.fbs.
+const (
+	headerSchema          = 1
+	headerDictionaryBatch = 2
+	headerRecordBatch     = 3
+)
+
+// metadataV5 is the metadata version written by this package.
+const metadataV5 = 4
+
+// Floating point precisions, date units and time units.
+const (
+	precisionHalf   = 0
+	precisionSingle = 1
+	precisionDouble = 2
+
+	dateDay = 0
+
+	unitSecond = 0
+	unitMilli  = 1
+	unitMicro  = 2
+)
+
+// continuation starts every encapsulated message since Arrow 0.15.
+const continuation = 0xffffffff
+
+// fileMagic starts and ends the IPC file format.
+const fileMagic = "ARROW1"
+
+// TypeKey is the key of the field metadata holding the series type tag of a column.
+const TypeKey = "go-starter.type"
+
+// field is a field of a schema.
+type field struct {
+	name     string
+	typ      uint8 // member of the Type union
+	bitWidth int32 // Int and Decimal
+	signed   bool  // Int
+	unit     int16 // precision of FloatingPoint, unit of Date and Timestamp
+	scale    int32 // Decimal
+	dict     *dictEncoding
+	metadata map[string]string
+	tag      string // series type tag of the decoded values
+}
+
+// dictEncoding describes a dictionary encoded field: the id of its dictionary and the
+// integer type of its indices.
+type dictEncoding struct {
+	id       int64
+	bitWidth int32
+	signed   bool
+}
+
+// message is an encapsulated IPC message.
+type message struct {
+	headerType uint8
+	header     fbReader
+	body       []byte
+}
+
+// readMessage decodes the message at pos and returns it with the position of the next one.
+// It returns false at the end of the stream.
+func readMessage(data []byte, pos int) (msg message, next int, ok bool, err error) {
+	defer recoverMalformed(&err)
+	if pos == len(data) {
+		return message{}, pos, false, nil
+	}
+	if pos < 0 || pos+4 > len(data) {
+		return message{}, 0, false, errTruncated
+	}
+	size := int(binary.LittleEndian.Uint32(data[pos:]))
+	pos += 4
+	if size == continuation {
+		if pos+4 > len(data) {
+			return message{}, 0, false, errTruncated
+		}
+		size = int(int32(binary.LittleEndian.Uint32(data[pos:])))
+		pos += 4
+	}
+	if size == 0 {
+		return message{}, pos, false, nil
+	}
+	if size < 0 || size > len(data)-pos {
+		return message{}, 0, false, errTruncated
+	}
+	root := rootTable(data[pos : pos+size])
+	pos += size
+	header, present := root.table(2)
+	if !present {
+		return message{}, 0, false, errors.New("message without header")
+	}
+	bodySize := root.int64(3, 0)
+	if bodySize < 0 || bodySize > int64(len(data)-pos) {
+		return message{}, 0, false, errTruncated
+	}
+	msg = message{headerType: root.uint8(1, 0), header: header, body: data[pos : pos+int(bodySize)]}
+	return msg, pos + int(bodySize), true, nil
+}
+
+var errTruncated = errors.New("unexpected end of data")
+
+// decodeSchema decodes the fields of a Schema table.
+func decodeSchema(schema fbReader) (fields []field, err error) {
+	defer recoverMalformed(&err)
+	if schema.int16(0, 0) != 0 {
+		return nil, errors.New("big-endian data is not supported")
+	}
+	for _, t := range schema.tables(1) {
+		f := field{name: t.string(0), typ: t.uint8(2, 0), metadata: t.keyValues(6)}
+		if _, n := t.vector(5, 4); n > 0 {
+			return nil, fmt.Errorf("field %q: nested types are not supported", f.name)
+		}
+		typ, _ := t.table(3)
+		switch f.typ {
+		case typeInt:
+			f.bitWidth, f.signed = typ.int32(0, 0), typ.bool(1, false)
+		case typeFloatingPoint:
+			f.unit = typ.int16(0, 0)
+		case typeDate:
+			f.unit = typ.int16(0, 1)
+		case typeTimestamp:
+			f.unit = typ.int16(0, 0)
+		case typeDecimal:
+			f.scale, f.bitWidth = typ.int32(1, 0), typ.int32(2, 128)
+		}
+		if d, ok := t.table(4); ok {
+			f.dict = &dictEncoding{id: d.int64(0, 0), bitWidth: 32, signed: true}
+			if index, ok := d.table(1); ok {
+				f.dict.bitWidth, f.dict.signed = index.int32(0, 32), index.bool(1, true)
+			}
+		}
+		if f.tag, err = fieldTag(f); err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.name, err)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// fieldTag returns the series type tag of a field: the one of its metadata when it is
+// compatible with the Arrow type, otherwise the tag matching the Arrow type.
+func fieldTag(f field) (string, error) {
+	var tag string
+	switch f.typ {
+	case typeNull, typeUtf8, typeLargeUtf8, typeBinary, typeLargeBinary:
+		tag = "string"
+	case typeInt:
+		if f.bitWidth != 8 && f.bitWidth != 16 && f.bitWidth != 32 && f.bitWidth != 64 {
+			return "", fmt.Errorf("invalid integer width %d", f.bitWidth)
+		}
+		tag = "number"
+	case typeFloatingPoint:
+		if f.unit < precisionHalf || f.unit > precisionDouble {
+			return "", fmt.Errorf("invalid floating point precision %d", f.unit)
+		}
+		tag = "float"
+	case typeDecimal:
+		if f.bitWidth%8 != 0 || f.bitWidth <= 0 || f.bitWidth > 256 {
+			return "", fmt.Errorf("invalid decimal width %d", f.bitWidth)
+		}
+		tag = "float"
+	case typeBool:
+		tag = "bool"
+	case typeDate, typeTimestamp:
+		tag = "date"
+	default:
+		return "", fmt.Errorf("unsupported type %d", f.typ)
+	}
+	if f.dict != nil && f.dict.bitWidth != 8 && f.dict.bitWidth != 16 && f.dict.bitWidth != 32 && f.dict.bitWidth != 64 {
+		return "", fmt.Errorf("invalid dictionary index width %d", f.dict.bitWidth)
+	}
+	switch meta := f.metadata[TypeKey]; {
+	case meta == tag, meta == "float" && tag == "number":
+		return meta, nil
+	case f.typ == typeNull && (meta == "number" || meta == "float" || meta == "bool" || meta == "date"):
+		return meta, nil
+	}
+	return tag, nil
+}
+
+// encodeMessage encapsulates a message: continuation marker, metadata size, metadata padded
+// to 8 bytes, then the body.
+func encodeMessage(headerType uint8, header fbTable, body []byte) (meta []byte, encoded []byte) {
+	meta = encodeFlatbuffer(fbTable{
+		{0, int16(metadataV5)},
+		{1, headerType},
+		{2, header},
+		{3, int64(len(body))},
+	})
+	encoded = binary.LittleEndian.AppendUint32(nil, continuation)
+	encoded = binary.LittleEndian.AppendUint32(encoded, uint32(len(meta)))
+	encoded = append(encoded, meta...)
+	return meta, append(encoded, body...)
+}
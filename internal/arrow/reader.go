@@ -0,0 +1,389 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// Column is a column of a table. Values are int for "number", float64 for "float", bool
+// for "bool", string for "string" and time.Time for "date"; nil is null.
+type Column struct {
+	Name   string
+	Type   string
+	Values []any
+}
+
+// IsFile reports whether data starts like the IPC file format rather than the stream format.
+func IsFile(data []byte) bool {
+	return len(data) >= len(fileMagic) && string(data[:len(fileMagic)]) == fileMagic
+}
+
+// Read decodes a table in the IPC file or stream format. Record batches are concatenated.
+func Read(data []byte) ([]Column, error) {
+	var r tableReader
+	var err error
+	if IsFile(data) {
+		err = r.readFile(data)
+	} else {
+		err = r.readStream(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("arrow: %w", err)
+	}
+	return r.columns, nil
+}
+
+// tableReader accumulates the columns of the record batches of a table.
+type tableReader struct {
+	fields  []field
+	columns []Column
+	dicts   map[int64][]any
+}
+
+func (r *tableReader) readStream(data []byte) error {
+	msg, pos, ok, err := readMessage(data, 0)
+	if err != nil {
+		return err
+	}
+	if !ok || msg.headerType != headerSchema {
+		return errors.New("stream does not start with a schema")
+	}
+	if err := r.setSchema(msg.header); err != nil {
+		return err
+	}
+	for {
+		if msg, pos, ok, err = readMessage(data, pos); err != nil || !ok {
+			return err
+		}
+		if err := r.readMessage(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *tableReader) readFile(data []byte) (err error) {
+	defer recoverMalformed(&err)
+	tail := len(fileMagic) + 4
+	if len(data) < 8+tail || string(data[len(data)-len(fileMagic):]) != fileMagic {
+		return errors.New("invalid file footer")
+	}
+	size := int(int32(binary.LittleEndian.Uint32(data[len(data)-tail:])))
+	if size <= 0 || size > len(data)-tail-8 {
+		return errors.New("invalid file footer size")
+	}
+	footer := rootTable(data[len(data)-tail-size : len(data)-tail])
+	schema, ok := footer.table(1)
+	if !ok {
+		return errors.New("file footer without schema")
+	}
+	if err := r.setSchema(schema); err != nil {
+		return err
+	}
+	// dictionaries first, then record batches; blocks are offset, metadata length, body length
+	for _, slot := range []int{2, 3} {
+		for _, block := range footer.structs(slot, 24) {
+			offset := int64(binary.LittleEndian.Uint64(block))
+			if offset < 0 || offset >= int64(len(data)) {
+				return errTruncated
+			}
+			msg, _, ok, err := readMessage(data, int(offset))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New("empty block")
+			}
+			if err := r.readMessage(msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *tableReader) setSchema(schema fbReader) error {
+	fields, err := decodeSchema(schema)
+	if err != nil {
+		return err
+	}
+	r.fields = fields
+	r.dicts = map[int64][]any{}
+	r.columns = make([]Column, len(fields))
+	for i, f := range fields {
+		r.columns[i] = Column{Name: f.name, Type: f.tag, Values: []any{}}
+	}
+	return nil
+}
+
+// readMessage decodes a dictionary batch or a record batch. Other messages are ignored.
+func (r *tableReader) readMessage(msg message) (err error) {
+	defer recoverMalformed(&err)
+	switch msg.headerType {
+	case headerDictionaryBatch:
+		id := msg.header.int64(0, 0)
+		batch, ok := msg.header.table(1)
+		if !ok {
+			return errors.New("dictionary batch without data")
+		}
+		var dictField *field
+		for i := range r.fields {
+			if r.fields[i].dict != nil && r.fields[i].dict.id == id {
+				dictField = &r.fields[i]
+			}
+		}
+		if dictField == nil {
+			return fmt.Errorf("dictionary %d is not used by the schema", id)
+		}
+		valueField := *dictField
+		valueField.dict = nil
+		values, err := decodeBatch(batch, msg.body, []field{valueField}, nil)
+		if err != nil {
+			return fmt.Errorf("dictionary %d: %w", id, err)
+		}
+		if msg.header.bool(2, false) {
+			r.dicts[id] = append(r.dicts[id], values[0]...)
+		} else {
+			r.dicts[id] = values[0]
+		}
+	case headerRecordBatch:
+		values, err := decodeBatch(msg.header, msg.body, r.fields, r.dicts)
+		if err != nil {
+			return err
+		}
+		for i := range r.columns {
+			r.columns[i].Values = append(r.columns[i].Values, values[i]...)
+		}
+	}
+	return nil
+}
+
+// decodeBatch decodes the columns of a RecordBatch table.
+func decodeBatch(batch fbReader, body []byte, fields []field, dicts map[int64][]any) ([][]any, error) {
+	if _, ok := batch.table(3); ok {
+		return nil, errors.New("compressed record batches are not supported")
+	}
+	nodes := batch.structs(1, 16)
+	buffers := batch.structs(2, 16)
+	if len(nodes) != len(fields) {
+		return nil, fmt.Errorf("record batch has %d nodes for %d fields", len(nodes), len(fields))
+	}
+	out := make([][]any, len(fields))
+	next := 0
+	for i, f := range fields {
+		count := 2
+		switch {
+		case f.dict != nil:
+		case f.typ == typeNull:
+			count = 0
+		case f.typ == typeUtf8 || f.typ == typeBinary || f.typ == typeLargeUtf8 || f.typ == typeLargeBinary:
+			count = 3
+		}
+		if next+count > len(buffers) {
+			return nil, errors.New("record batch has too few buffers")
+		}
+		bufs := make([][]byte, count)
+		for j := range bufs {
+			offset := int64(binary.LittleEndian.Uint64(buffers[next+j]))
+			size := int64(binary.LittleEndian.Uint64(buffers[next+j][8:]))
+			if offset < 0 || size < 0 || offset > int64(len(body)) || size > int64(len(body))-offset {
+				return nil, fmt.Errorf("field %q: buffer out of the message body", f.name)
+			}
+			bufs[j] = body[offset : offset+size]
+		}
+		next += count
+		length := int64(binary.LittleEndian.Uint64(nodes[i]))
+		if length < 0 || length > int64(len(body))*8+1<<20 {
+			return nil, fmt.Errorf("field %q: invalid length %d", f.name, length)
+		}
+		values, err := decodeArray(f, int(length), bufs, dicts)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.name, err)
+		}
+		out[i] = values
+	}
+	return out, nil
+}
+
+// decodeArray decodes the values of an array from its buffers: the validity bitmap first,
+// then the offsets and the data of variable size types or the values of fixed size types.
+func decodeArray(f field, n int, bufs [][]byte, dicts map[int64][]any) ([]any, error) {
+	out := make([]any, n)
+	if f.typ == typeNull && f.dict == nil {
+		return out, nil
+	}
+	validity := bufs[0]
+	if len(validity) > 0 && len(validity) < (n+7)/8 {
+		return nil, errTruncated
+	}
+	valid := func(i int) bool {
+		return len(validity) == 0 || validity[i/8]>>(i%8)&1 == 1
+	}
+
+	if f.dict != nil {
+		dict, ok := dicts[f.dict.id]
+		if !ok {
+			return nil, fmt.Errorf("missing dictionary %d", f.dict.id)
+		}
+		width := int(f.dict.bitWidth / 8)
+		if len(bufs[1]) < n*width {
+			return nil, errTruncated
+		}
+		for i := range out {
+			if !valid(i) {
+				continue
+			}
+			idx := readInt(bufs[1][i*width:], width, f.dict.signed)
+			if idx < 0 || idx >= int64(len(dict)) {
+				return nil, fmt.Errorf("dictionary index %d out of range", idx)
+			}
+			out[i] = dict[idx]
+		}
+		return out, nil
+	}
+
+	switch f.typ {
+	case typeUtf8, typeBinary, typeLargeUtf8, typeLargeBinary:
+		width := 4
+		if f.typ == typeLargeUtf8 || f.typ == typeLargeBinary {
+			width = 8
+		}
+		offsets, data := bufs[1], bufs[2]
+		if n > 0 && len(offsets) < (n+1)*width {
+			return nil, errTruncated
+		}
+		for i := range out {
+			if !valid(i) {
+				continue
+			}
+			start, end := readInt(offsets[i*width:], width, true), readInt(offsets[(i+1)*width:], width, true)
+			if start < 0 || end < start || end > int64(len(data)) {
+				return nil, errors.New("invalid offsets")
+			}
+			out[i] = string(data[start:end])
+		}
+		return out, nil
+	case typeBool:
+		if len(bufs[1]) < (n+7)/8 {
+			return nil, errTruncated
+		}
+		for i := range out {
+			if valid(i) {
+				out[i] = bufs[1][i/8]>>(i%8)&1 == 1
+			}
+		}
+		return out, nil
+	}
+
+	width := valueWidth(f)
+	if len(bufs[1]) < n*width {
+		return nil, errTruncated
+	}
+	for i := range out {
+		if valid(i) {
+			out[i] = decodeValue(f, bufs[1][i*width:(i+1)*width])
+		}
+	}
+	return out, nil
+}
+
+// valueWidth returns the size in bytes of the values of a fixed size type.
+func valueWidth(f field) int {
+	switch f.typ {
+	case typeInt, typeDecimal:
+		return int(f.bitWidth / 8)
+	case typeFloatingPoint:
+		return 2 << f.unit
+	case typeDate:
+		if f.unit == dateDay {
+			return 4
+		}
+	}
+	return 8
+}
+
+// readInt reads a little-endian integer of the given width.
+func readInt(b []byte, width int, signed bool) int64 {
+	var u uint64
+	for i := range width {
+		u |= uint64(b[i]) << (8 * i)
+	}
+	if signed && width < 8 {
+		shift := 64 - 8*width
+		return int64(u<<shift) >> shift
+	}
+	return int64(u)
+}
+
+// decodeValue converts a fixed size value to the canonical Go value of the field type tag.
+func decodeValue(f field, b []byte) any {
+	switch f.typ {
+	case typeInt:
+		v := readInt(b, len(b), f.signed)
+		if f.tag == "float" {
+			return float64(v)
+		}
+		return int(v)
+	case typeFloatingPoint:
+		switch f.unit {
+		case precisionHalf:
+			return halfToFloat(binary.LittleEndian.Uint16(b))
+		case precisionSingle:
+			return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	case typeDate:
+		if f.unit == dateDay {
+			return time.Unix(readInt(b, 4, true)*86400, 0).UTC()
+		}
+		return time.UnixMilli(readInt(b, 8, true)).UTC()
+	case typeTimestamp:
+		v := readInt(b, 8, true)
+		switch f.unit {
+		case unitSecond:
+			return time.Unix(v, 0).UTC()
+		case unitMilli:
+			return time.UnixMilli(v).UTC()
+		case unitMicro:
+			return time.UnixMicro(v).UTC()
+		}
+		return time.Unix(0, v).UTC()
+	case typeDecimal:
+		// little-endian two's complement unscaled value
+		be := make([]byte, len(b))
+		for i := range b {
+			be[len(b)-1-i] = b[i]
+		}
+		n := new(big.Int).SetBytes(be)
+		if be[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+		}
+		scale := new(big.Float).SetFloat64(math.Pow10(int(f.scale)))
+		v, _ := new(big.Float).Quo(new(big.Float).SetInt(n), scale).Float64()
+		return v
+	}
+	return nil
+}
+
+// halfToFloat converts an IEEE 754 half precision float to float64.
+func halfToFloat(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h >> 10 & 0x1f)
+	frac := float64(h & 0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(frac, -24)
+	case 0x1f:
+		if frac != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(1+frac/1024, exp-15)
+}
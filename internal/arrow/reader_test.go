@@ -0,0 +1,142 @@
+package arrow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// recordBatch encodes a RecordBatch table of n rows and its body: a node per null count and
+// the buffers of every field, in order.
+func recordBatch(n int, nulls []int, buffers ...[]byte) (fbTable, []byte) {
+	var nodes, locations, body []byte
+	for _, count := range nulls {
+		nodes = binary.LittleEndian.AppendUint64(binary.LittleEndian.AppendUint64(nodes, uint64(n)), uint64(count))
+	}
+	for _, b := range buffers {
+		locations = binary.LittleEndian.AppendUint64(locations, uint64(len(body)))
+		locations = binary.LittleEndian.AppendUint64(locations, uint64(len(b)))
+		body = append(body, b...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+	return fbTable{
+		{0, int64(n)},
+		{1, fbStructs{count: len(nulls), data: nodes}},
+		{2, fbStructs{count: len(buffers), data: locations}},
+	}, body
+}
+
+// stream assembles a stream from a schema and messages encoded by encodeMessage.
+func stream(fields []fbTable, messages ...[]byte) []byte {
+	_, out := encodeMessage(headerSchema, fbTable{{1, fields}}, nil)
+	for _, m := range messages {
+		out = append(out, m...)
+	}
+	return binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(out, continuation), 0)
+}
+
+// le encodes integers of the given width in little-endian order.
+func le(width int, values ...int64) []byte {
+	var out []byte
+	for _, v := range values {
+		for i := range width {
+			out = append(out, byte(uint64(v)>>(8*i)))
+		}
+	}
+	return out
+}
+
+func TestRead(t *testing.T) {
+	tag := func(t string) []fbTable { return []fbTable{{{0, TypeKey}, {1, t}}} }
+	fields := []fbTable{
+		{{0, "u8"}, {2, uint8(typeInt)}, {3, fbTable{{0, int32(8)}}}},
+		{{0, "i16"}, {2, uint8(typeInt)}, {3, fbTable{{0, int32(16)}, {1, true}}}, {6, tag("float")}},
+		{{0, "half"}, {2, uint8(typeFloatingPoint)}, {3, fbTable{{0, int16(precisionHalf)}}}},
+		{{0, "day"}, {2, uint8(typeDate)}, {3, fbTable{{0, int16(dateDay)}}}},
+		{{0, "ms"}, {2, uint8(typeTimestamp)}, {3, fbTable{{0, int16(unitMilli)}}}, {6, tag("string")}},
+		{{0, "dec"}, {2, uint8(typeDecimal)}, {3, fbTable{{0, int32(10)}, {1, int32(2)}}}},
+		{{0, "large"}, {2, uint8(typeLargeUtf8)}, {3, fbTable{}}},
+		{{0, "cat"}, {2, uint8(typeUtf8)}, {3, fbTable{}},
+			{4, fbTable{{0, int64(3)}, {1, fbTable{{0, int32(8)}, {1, true}}}}}},
+		{{0, "nothing"}, {2, uint8(typeNull)}, {3, fbTable{}}, {6, tag("number")}},
+	}
+
+	dictHeader, dictBody := recordBatch(2, []int{0}, nil, le(4, 0, 1, 2), []byte("xy"))
+	_, dict := encodeMessage(headerDictionaryBatch, fbTable{{0, int64(3)}, {1, dictHeader}}, dictBody)
+	header, body := recordBatch(3, []int{0, 1, 0, 0, 0, 0, 0, 1, 3},
+		nil, []byte{200, 1, 255},
+		[]byte{0b101}, le(2, -5, 0, 7),
+		nil, le(2, 0x3e00, 0xc000, 0),
+		nil, le(4, 19000, 0, -1),
+		nil, le(8, 1000, 0, -1),
+		nil, append(append(le(8, 12345, 0), le(8, -1, -1)...), le(8, 0, 0)...),
+		nil, le(8, 0, 1, 1, 3), []byte("abc"),
+		[]byte{0b110}, []byte{0, 1, 0},
+	)
+	_, batch := encodeMessage(headerRecordBatch, header, body)
+
+	got, err := Read(stream(fields, dict, batch))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := []Column{
+		{Name: "u8", Type: "number", Values: []any{200, 1, 255}},
+		{Name: "i16", Type: "float", Values: []any{-5.0, nil, 7.0}},
+		{Name: "half", Type: "float", Values: []any{1.5, -2.0, 0.0}},
+		{Name: "day", Type: "date", Values: []any{
+			time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), time.Unix(0, 0).UTC(), time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)}},
+		{Name: "ms", Type: "date", Values: []any{time.Unix(1, 0).UTC(), time.Unix(0, 0).UTC(), time.UnixMilli(-1).UTC()}},
+		{Name: "dec", Type: "float", Values: []any{123.45, -0.01, 0.0}},
+		{Name: "large", Type: "string", Values: []any{"a", "", "bc"}},
+		{Name: "cat", Type: "string", Values: []any{nil, "y", "x"}},
+		{Name: "nothing", Type: "number", Values: []any{nil, nil, nil}},
+	}
+	if !is.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRead_errors(t *testing.T) {
+	var valid bytes.Buffer
+	if err := Write(&valid, testColumns(), WriteOptions{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	header, body := recordBatch(1, []int{0}, nil, le(8, 1))
+	compressed := append(header, fbField{3, fbTable{}})
+	_, compressedBatch := encodeMessage(headerRecordBatch, compressed, body)
+	_, batch := encodeMessage(headerRecordBatch, header, body)
+	number := fbTable{{0, "a"}, {2, uint8(typeInt)}, {3, fbTable{{0, int32(64)}, {1, true}}}}
+	dictionary := fbTable{{0, "a"}, {2, uint8(typeUtf8)}, {3, fbTable{}}, {4, fbTable{{0, int64(1)}}}}
+	_, bigEndian := encodeMessage(headerSchema, fbTable{{0, int16(1)}, {1, []fbTable{number}}}, nil)
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		wantErr string
+	}{
+		{"empty", nil, "stream does not start with a schema"},
+		{"truncated stream", valid.Bytes()[8:100], "unexpected end of data"},
+		{"truncated file", valid.Bytes()[:len(valid.Bytes())-1], "invalid file footer"},
+		{"big-endian", bigEndian, "big-endian data is not supported"},
+		{"nested", stream([]fbTable{append(slices.Clone(number), fbField{5, []fbTable{number}})}), `field "a": nested types are not supported`},
+		{"unsupported type", stream([]fbTable{{{0, "a"}, {2, uint8(17)}}}), `field "a": unsupported type 17`},
+		{"compressed", stream([]fbTable{number}, compressedBatch), "compressed record batches are not supported"},
+		{"missing dictionary", stream([]fbTable{dictionary}, batch), `field "a": missing dictionary 1`},
+		{"nodes", stream([]fbTable{number, number}, batch), "record batch has 1 nodes for 2 fields"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Read(tc.data)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
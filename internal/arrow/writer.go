@@ -0,0 +1,209 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Formats accepted by Write.
+const (
+	FormatFile   = "file"
+	FormatStream = "stream"
+)
+
+// WriteOptions configures Write.
+type WriteOptions struct {
+	Format    string // FormatFile or FormatStream; FormatFile when empty
+	BatchSize int    // rows per record batch; a single batch when 0
+}
+
+// fieldTypes maps the series type tags to the Arrow type of their column: "number" is a
+// signed 64-bit Int, "float" a double FloatingPoint, "bool" a Bool, "string" a Utf8 and
+// "date" a UTC Timestamp in microseconds.
+var fieldTypes = map[string]struct {
+	typ    uint8
+	params fbTable
+}{
+	"number": {typeInt, fbTable{{0, int32(64)}, {1, true}}},
+	"float":  {typeFloatingPoint, fbTable{{0, int16(precisionDouble)}}},
+	"bool":   {typeBool, fbTable{}},
+	"string": {typeUtf8, fbTable{}},
+	"date":   {typeTimestamp, fbTable{{0, int16(unitMicro)}, {1, "UTC"}}},
+}
+
+// block locates a message of the file format: offset, metadata length and body length.
+type block struct {
+	offset     int64
+	metaLength int32
+	bodyLength int64
+}
+
+// Write writes the columns as an Arrow IPC file or stream. Every field is nullable and holds
+// the series type tag of its column in its metadata (TypeKey).
+func Write(w io.Writer, columns []Column, opts WriteOptions) error {
+	format := opts.Format
+	if format == "" {
+		format = FormatFile
+	}
+	if format != FormatFile && format != FormatStream {
+		return fmt.Errorf("arrow: unknown format %q", opts.Format)
+	}
+
+	var fields []fbTable
+	names := map[string]bool{}
+	rows := 0
+	for i, c := range columns {
+		ft, ok := fieldTypes[c.Type]
+		if !ok {
+			return fmt.Errorf("arrow: column %q: unknown type %q", c.Name, c.Type)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("arrow: duplicated column %q", c.Name)
+		}
+		names[c.Name] = true
+		if i == 0 {
+			rows = len(c.Values)
+		} else if len(c.Values) != rows {
+			return fmt.Errorf("arrow: column %q has %d values, expected %d", c.Name, len(c.Values), rows)
+		}
+		fields = append(fields, fbTable{
+			{0, c.Name},
+			{1, true},
+			{2, ft.typ},
+			{3, ft.params},
+			{5, []fbTable{}},
+			{6, []fbTable{{{0, TypeKey}, {1, c.Type}}}},
+		})
+	}
+	schema := fbTable{{0, int16(0)}, {1, fields}}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = max(rows, 1)
+	}
+
+	var out []byte
+	if format == FormatFile {
+		out = append([]byte(fileMagic), 0, 0)
+	}
+	_, encoded := encodeMessage(headerSchema, schema, nil)
+	out = append(out, encoded...)
+	var blocks []block
+	for start := 0; start < rows; start += batchSize {
+		end := min(start+batchSize, rows)
+		header, body, err := encodeBatch(columns, start, end)
+		if err != nil {
+			return err
+		}
+		meta, encoded := encodeMessage(headerRecordBatch, header, body)
+		blocks = append(blocks, block{int64(len(out)), int32(8 + len(meta)), int64(len(body))})
+		out = append(out, encoded...)
+	}
+	// end of stream marker
+	out = binary.LittleEndian.AppendUint32(out, continuation)
+	out = binary.LittleEndian.AppendUint32(out, 0)
+
+	if format == FormatFile {
+		var data []byte
+		for _, b := range blocks {
+			data = binary.LittleEndian.AppendUint64(data, uint64(b.offset))
+			data = binary.LittleEndian.AppendUint32(data, uint32(b.metaLength))
+			data = binary.LittleEndian.AppendUint32(data, 0)
+			data = binary.LittleEndian.AppendUint64(data, uint64(b.bodyLength))
+		}
+		footer := encodeFlatbuffer(fbTable{
+			{0, int16(metadataV5)},
+			{1, schema},
+			{2, fbStructs{}},
+			{3, fbStructs{count: len(blocks), data: data}},
+		})
+		out = append(out, footer...)
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(footer)))
+		out = append(out, fileMagic...)
+	}
+	_, err := w.Write(out)
+	return err
+}
+
+// encodeBatch encodes the rows [start, end) of the columns as a RecordBatch table and its
+// body. Buffers are padded to 8 bytes; the validity bitmap is omitted without nulls.
+func encodeBatch(columns []Column, start, end int) (fbTable, []byte, error) {
+	n := end - start
+	var nodes, buffers, body []byte
+	addBuffer := func(data []byte) {
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(body)))
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(data)))
+		body = append(body, data...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+	for _, c := range columns {
+		values := c.Values[start:end]
+		validity := make([]byte, (n+7)/8)
+		nulls := 0
+		var data, offsets []byte
+		if c.Type == "string" {
+			offsets = binary.LittleEndian.AppendUint32(offsets, 0)
+		}
+		if c.Type == "bool" {
+			data = make([]byte, (n+7)/8)
+		}
+		for i, v := range values {
+			if v == nil {
+				nulls++
+			} else {
+				validity[i/8] |= 1 << (i % 8)
+			}
+			var ok bool
+			switch c.Type {
+			case "number":
+				var x int
+				x, ok = v.(int)
+				data = binary.LittleEndian.AppendUint64(data, uint64(x))
+			case "float":
+				var x float64
+				x, ok = v.(float64)
+				data = binary.LittleEndian.AppendUint64(data, math.Float64bits(x))
+			case "bool":
+				var x bool
+				if x, ok = v.(bool); x {
+					data[i/8] |= 1 << (i % 8)
+				}
+			case "string":
+				var x string
+				x, ok = v.(string)
+				data = append(data, x...)
+				offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data)))
+			case "date":
+				var x time.Time
+				if x, ok = v.(time.Time); ok {
+					data = binary.LittleEndian.AppendUint64(data, uint64(x.UnixMicro()))
+				} else {
+					data = binary.LittleEndian.AppendUint64(data, 0)
+				}
+			}
+			if !ok && v != nil {
+				return nil, nil, fmt.Errorf("arrow: column %q: row %d: cannot write %v (%T) as %s", c.Name, start+i, v, v, c.Type)
+			}
+		}
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(n))
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(nulls))
+		if nulls == 0 {
+			validity = nil
+		}
+		addBuffer(validity)
+		if offsets != nil {
+			addBuffer(offsets)
+		}
+		addBuffer(data)
+	}
+	header := fbTable{
+		{0, int64(n)},
+		{1, fbStructs{count: len(columns), data: nodes}},
+		{2, fbStructs{count: len(buffers) / 16, data: buffers}},
+	}
+	return header, body, nil
+}
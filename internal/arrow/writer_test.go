@@ -0,0 +1,92 @@
+package arrow
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func testColumns() []Column {
+	day := time.Date(2024, 3, 1, 12, 30, 0, 123456000, time.UTC)
+	return []Column{
+		{Name: "id", Type: "number", Values: []any{1, -2, nil, 1 << 40, 5}},
+		{Name: "score", Type: "float", Values: []any{1.5, nil, -0.25, 3.0, 0.0}},
+		{Name: "active", Type: "bool", Values: []any{true, false, nil, true, true}},
+		{Name: "name", Type: "string", Values: []any{"Ana", "", nil, "Zoë", "Bob"}},
+		{Name: "since", Type: "date", Values: []any{day, nil, day.AddDate(-60, 0, 0), day, day}},
+		{Name: "full", Type: "number", Values: []any{1, 2, 3, 4, 5}},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts WriteOptions
+		file bool
+	}{
+		{"file", WriteOptions{}, true},
+		{"stream", WriteOptions{Format: FormatStream}, false},
+		{"file batches", WriteOptions{Format: FormatFile, BatchSize: 2}, true},
+		{"stream batches", WriteOptions{Format: FormatStream, BatchSize: 3}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			columns := testColumns()
+			if err := Write(&buf, columns, tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if IsFile(buf.Bytes()) != tc.file {
+				tt.Errorf("expected IsFile %v", tc.file)
+			}
+			got, err := Read(buf.Bytes())
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if !is.Equal(got, columns) {
+				tt.Errorf("expected %v, got %v", columns, got)
+			}
+		})
+	}
+}
+
+func TestWrite_empty(t *testing.T) {
+	columns := []Column{{Name: "a", Type: "date", Values: []any{}}, {Name: "b", Type: "string", Values: []any{}}}
+	for _, format := range []string{FormatFile, FormatStream} {
+		var buf bytes.Buffer
+		if err := Write(&buf, columns, WriteOptions{Format: format}); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		got, err := Read(buf.Bytes())
+		if err != nil || !is.Equal(got, columns) {
+			t.Errorf("%s: expected %v, got %v (%v)", format, columns, got, err)
+		}
+	}
+}
+
+func TestWrite_errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		columns []Column
+		opts    WriteOptions
+		wantErr string
+	}{
+		{"unknown format", nil, WriteOptions{Format: "feather"}, `unknown format "feather"`},
+		{"unknown type", []Column{{Name: "a", Type: "decimal"}}, WriteOptions{}, `column "a": unknown type "decimal"`},
+		{"duplicated column", []Column{{Name: "a", Type: "bool"}, {Name: "a", Type: "bool"}}, WriteOptions{}, `duplicated column "a"`},
+		{"lengths", []Column{{Name: "a", Type: "bool", Values: []any{true}}, {Name: "b", Type: "bool"}}, WriteOptions{}, `column "b" has 0 values, expected 1`},
+		{"value type", []Column{{Name: "a", Type: "number", Values: []any{1, "2"}}}, WriteOptions{}, `column "a": row 1: cannot write 2 (string) as number`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tc.columns, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
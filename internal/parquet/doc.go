@@ -0,0 +1,6 @@
+// Package parquet reads and writes flat Parquet files with the standard library only.
+// It implements the parts of the format needed by the extract and load packages: the thrift
+// compact protocol of the metadata, v1 and v2 data pages, PLAIN, dictionary and RLE encodings,
+// and the uncompressed, snappy and gzip codecs. Values are exchanged as the canonical Go
+// values of the series type tags.
+package parquet
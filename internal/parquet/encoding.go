@@ -0,0 +1,178 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// bitWidth returns the number of bits needed to store values up to max.
+func bitWidth(max int) int {
+	return bits.Len(uint(max))
+}
+
+// decodeHybrid decodes count values of the RLE/bit-packed hybrid encoding written with the
+// given bit width. It returns the values and the number of bytes consumed.
+func decodeHybrid(data []byte, width int, count int) ([]int32, int, error) {
+	if width < 0 || width > 32 {
+		return nil, 0, fmt.Errorf("invalid bit width %d", width)
+	}
+	out := make([]int32, 0, min(count, 1<<16))
+	pos := 0
+	byteWidth := (width + 7) / 8
+	for len(out) < count {
+		header, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, 0, errTruncated
+		}
+		pos += n
+		if header&1 == 0 {
+			// RLE run: a repeated value stored on byteWidth bytes
+			run := int(min(header>>1, uint64(count-len(out))))
+			if pos+byteWidth > len(data) {
+				return nil, 0, errTruncated
+			}
+			var v uint32
+			for i := range byteWidth {
+				v |= uint32(data[pos+i]) << (8 * i)
+			}
+			pos += byteWidth
+			for range run {
+				out = append(out, int32(v))
+			}
+			continue
+		}
+		// bit-packed run: groups of 8 values packed from the least significant bit
+		groups := header >> 1
+		if groups > uint64(len(data)) {
+			return nil, 0, errTruncated
+		}
+		size := int(groups) * width
+		if pos+size > len(data) {
+			return nil, 0, errTruncated
+		}
+		packed := data[pos : pos+size]
+		pos += size
+		for i := 0; i < int(groups)*8 && len(out) < count; i++ {
+			var v uint32
+			for b := range width {
+				bit := i*width + b
+				v |= uint32(packed[bit/8]>>(bit%8)&1) << b
+			}
+			out = append(out, int32(v))
+		}
+	}
+	return out, pos, nil
+}
+
+// encodeHybrid encodes values with the RLE/bit-packed hybrid encoding, using RLE runs only.
+func encodeHybrid(values []int32, width int) []byte {
+	var out []byte
+	byteWidth := (width + 7) / 8
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+		out = binary.AppendUvarint(out, uint64(j-i)<<1)
+		for b := range byteWidth {
+			out = append(out, byte(uint32(values[i])>>(8*b)))
+		}
+		i = j
+	}
+	return out
+}
+
+// decodeLengthPrefixedHybrid decodes hybrid encoded values preceded by their byte length
+// on 4 bytes, as written for levels of v1 data pages and RLE booleans.
+func decodeLengthPrefixedHybrid(data []byte, width int, count int) ([]int32, int, error) {
+	if len(data) < 4 {
+		return nil, 0, errTruncated
+	}
+	size := int(binary.LittleEndian.Uint32(data))
+	if size < 0 || 4+size > len(data) {
+		return nil, 0, errTruncated
+	}
+	values, _, err := decodeHybrid(data[4:4+size], width, count)
+	return values, 4 + size, err
+}
+
+// decodePlain decodes count values of the given physical type with the PLAIN encoding.
+// Values are returned as bool, int32, int64, float32, float64 or []byte (also for INT96).
+func decodePlain(data []byte, physical int32, typeLength int, count int) ([]any, error) {
+	if count < 0 {
+		return nil, errors.New("negative value count")
+	}
+	size := map[int32]int{typeInt32: 4, typeInt64: 8, typeInt96: 12, typeFloat: 4, typeDouble: 8, typeFixedLenByteArray: typeLength}[physical]
+	// every value takes at least size bytes (4 for the length of a byte array, 1 bit for a boolean)
+	switch {
+	case physical == typeBoolean && count > len(data)*8,
+		physical == typeByteArray && count > len(data)/4,
+		size > 0 && count > len(data)/size:
+		return nil, errTruncated
+	}
+	out := make([]any, count)
+	pos := 0
+	for i := range out {
+		switch physical {
+		case typeBoolean:
+			out[i] = data[i/8]>>(i%8)&1 == 1
+			continue
+		case typeInt32:
+			out[i] = int32(binary.LittleEndian.Uint32(data[pos:]))
+		case typeInt64:
+			out[i] = int64(binary.LittleEndian.Uint64(data[pos:]))
+		case typeFloat:
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[pos:]))
+		case typeDouble:
+			out[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[pos:]))
+		case typeInt96, typeFixedLenByteArray:
+			out[i] = data[pos : pos+size]
+		case typeByteArray:
+			if pos+4 > len(data) {
+				return nil, errTruncated
+			}
+			n := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if n < 0 || pos+n > len(data) {
+				return nil, errTruncated
+			}
+			out[i] = data[pos : pos+n]
+			pos += n
+			continue
+		default:
+			return nil, fmt.Errorf("unsupported physical type %d", physical)
+		}
+		pos += size
+	}
+	return out, nil
+}
+
+// encodePlain appends the PLAIN encoding of values of the given physical type to out.
+// Values must be bool, int64, float64 or []byte matching the physical type.
+func encodePlain(out []byte, values []any, physical int32) []byte {
+	if physical == typeBoolean {
+		packed := make([]byte, (len(values)+7)/8)
+		for i, v := range values {
+			if v.(bool) {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		return append(out, packed...)
+	}
+	for _, v := range values {
+		switch physical {
+		case typeInt64:
+			out = binary.LittleEndian.AppendUint64(out, uint64(v.(int64)))
+		case typeDouble:
+			out = binary.LittleEndian.AppendUint64(out, math.Float64bits(v.(float64)))
+		case typeByteArray:
+			b := v.([]byte)
+			out = binary.LittleEndian.AppendUint32(out, uint32(len(b)))
+			out = append(out, b...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package parquet
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestDecodeHybrid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []byte
+		width    int
+		count    int
+		expected []int32
+	}{
+		// example of the specification: 0 to 7 bit-packed on 3 bits
+		{"bit-packed", []byte{3, 0x88, 0xc6, 0xfa}, 3, 8, []int32{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"bit-packed with padding", []byte{3, 0x88, 0xc6, 0xfa}, 3, 5, []int32{0, 1, 2, 3, 4}},
+		{"RLE runs", []byte{3 << 1, 1, 2 << 1, 0}, 1, 5, []int32{1, 1, 1, 0, 0}},
+		{"RLE on two bytes", []byte{2 << 1, 0x2c, 0x01}, 9, 2, []int32{300, 300}},
+		{"zero width", []byte{4 << 1}, 0, 4, []int32{0, 0, 0, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, n, err := decodeHybrid(tc.data, tc.width, tc.count)
+			if err != nil || n != len(tc.data) || !is.SameSlice(got, tc.expected) {
+				tt.Errorf("expected %v, got %v (%d bytes, %v)", tc.expected, got, n, err)
+			}
+		})
+	}
+	if _, _, err := decodeHybrid([]byte{3, 0x88}, 3, 8); err == nil {
+		t.Errorf("expected an error for truncated data")
+	}
+}
+
+func TestEncodeHybrid(t *testing.T) {
+	values := []int32{1, 1, 0, 1, 1, 1, 0, 0}
+	got, _, err := decodeHybrid(encodeHybrid(values, 1), 1, len(values))
+	if err != nil || !is.SameSlice(got, values) {
+		t.Errorf("expected %v, got %v (%v)", values, got, err)
+	}
+}
+
+func TestPlain(t *testing.T) {
+	testCases := []struct {
+		name     string
+		physical int32
+		values   []any
+	}{
+		{"bool", typeBoolean, []any{true, false, false, true, true, true, false, true, true}},
+		{"int64", typeInt64, []any{int64(-1), int64(1) << 40}},
+		{"double", typeDouble, []any{1.5, -0.25}},
+		{"byte array", typeByteArray, []any{[]byte("ab"), []byte{}, []byte("é")}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := decodePlain(encodePlain(nil, tc.values, tc.physical), tc.physical, 0, len(tc.values))
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if !is.Equal(got, tc.values) {
+				tt.Errorf("expected %v, got %v", tc.values, got)
+			}
+		})
+	}
+	if _, err := decodePlain([]byte{1, 0, 0, 0}, typeByteArray, 0, 1); err == nil {
+		t.Errorf("expected an error for a truncated byte array")
+	}
+	if _, err := decodePlain(make([]byte, 8), typeInt64, 0, 2); err == nil {
+		t.Errorf("expected an error for missing values")
+	}
+}
@@ -0,0 +1,523 @@
+package parquet
+
+import "fmt"
+
+// Physical types.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Field repetition types.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// Converted types (legacy logical annotations).
+const (
+	convertedUTF8            = 0
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+)
+
+// Logical types, identified by their field id in the LogicalType union.
+const (
+	logicalString    = 1
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalUUID      = 14
+)
+
+// Time units of the TIMESTAMP logical type, identified by their field id in the TimeUnit union.
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+// Encodings.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingRLEDictionary   = 8
+)
+
+// Compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+)
+
+// Page types.
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// fileMetaData is the footer of a Parquet file. Only the fields used by this package are kept.
+type fileMetaData struct {
+	version   int32
+	schema    []schemaElement
+	numRows   int64
+	rowGroups []rowGroup
+	createdBy string
+}
+
+type schemaElement struct {
+	name          string
+	physical      int32 // -1 for groups
+	typeLength    int32
+	repetition    int32
+	numChildren   int32
+	convertedType int32 // -1 when absent
+	scale         int32
+	logical       logicalType
+}
+
+// logicalType is the member set in the LogicalType union (0 when absent) with the parameters
+// of the members this package understands.
+type logicalType struct {
+	kind     int16
+	unit     int16 // TIMESTAMP unit
+	scale    int32 // DECIMAL scale
+	adjusted bool  // TIMESTAMP isAdjustedToUTC
+}
+
+type rowGroup struct {
+	columns       []columnChunk
+	totalByteSize int64
+	numRows       int64
+}
+
+type columnChunk struct {
+	fileOffset int64
+	meta       columnMetaData
+}
+
+type columnMetaData struct {
+	physical              int32
+	encodings             []int32
+	path                  []string
+	codec                 int32
+	numValues             int64
+	totalUncompressedSize int64
+	totalCompressedSize   int64
+	dataPageOffset        int64
+	dictionaryPageOffset  int64 // 0 when absent
+}
+
+type pageHeader struct {
+	typ              int32
+	uncompressedSize int32
+	compressedSize   int32
+	data             dataPageHeader
+	dictionary       dictionaryPageHeader
+	dataV2           dataPageHeaderV2
+}
+
+type dataPageHeader struct {
+	numValues        int32
+	encoding         int32
+	defLevelEncoding int32
+}
+
+type dictionaryPageHeader struct {
+	numValues int32
+	encoding  int32
+}
+
+type dataPageHeaderV2 struct {
+	numValues    int32
+	numNulls     int32
+	encoding     int32
+	defLength    int32
+	repLength    int32
+	isCompressed bool
+}
+
+// readFileMetaData decodes the footer of a file.
+func readFileMetaData(r *compactReader) (fileMetaData, error) {
+	var m fileMetaData
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.version, err = r.i32()
+		case id == 2 && typ == thriftList:
+			err = readList(r, func() error {
+				e, err := readSchemaElement(r)
+				m.schema = append(m.schema, e)
+				return err
+			})
+		case id == 3 && typ == thriftI64:
+			m.numRows, err = r.zigzag()
+		case id == 4 && typ == thriftList:
+			err = readList(r, func() error {
+				g, err := readRowGroup(r)
+				m.rowGroups = append(m.rowGroups, g)
+				return err
+			})
+		case id == 6 && typ == thriftBinary:
+			m.createdBy, err = r.str()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return m, err
+}
+
+// readList reads a list header and calls elem for each element.
+func readList(r *compactReader, elem func() error) error {
+	size, _, err := r.listHeader()
+	if err != nil {
+		return err
+	}
+	for range size {
+		if err := elem(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSchemaElement(r *compactReader) (schemaElement, error) {
+	e := schemaElement{physical: -1, convertedType: -1}
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			e.physical, err = r.i32()
+		case id == 2 && typ == thriftI32:
+			e.typeLength, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			e.repetition, err = r.i32()
+		case id == 4 && typ == thriftBinary:
+			e.name, err = r.str()
+		case id == 5 && typ == thriftI32:
+			e.numChildren, err = r.i32()
+		case id == 6 && typ == thriftI32:
+			e.convertedType, err = r.i32()
+		case id == 7 && typ == thriftI32:
+			e.scale, err = r.i32()
+		case id == 10 && typ == thriftStruct:
+			e.logical, err = readLogicalType(r)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return e, err
+}
+
+func readLogicalType(r *compactReader) (logicalType, error) {
+	var l logicalType
+	err := r.readStruct(func(id int16, typ byte) error {
+		if typ != thriftStruct {
+			return r.skip(typ)
+		}
+		l.kind = id
+		switch id {
+		case logicalTimestamp:
+			return r.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && (typ == thriftTrue || typ == thriftFalse):
+					l.adjusted = typ == thriftTrue
+				case id == 2 && typ == thriftStruct:
+					return r.readStruct(func(id int16, typ byte) error {
+						l.unit = id
+						return r.skip(typ)
+					})
+				default:
+					return r.skip(typ)
+				}
+				return nil
+			})
+		case logicalDecimal:
+			return r.readStruct(func(id int16, typ byte) error {
+				if id == 1 && typ == thriftI32 {
+					var err error
+					l.scale, err = r.i32()
+					return err
+				}
+				return r.skip(typ)
+			})
+		}
+		return r.skip(typ)
+	})
+	return l, err
+}
+
+func readRowGroup(r *compactReader) (rowGroup, error) {
+	var g rowGroup
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftList:
+			err = readList(r, func() error {
+				c, err := readColumnChunk(r)
+				g.columns = append(g.columns, c)
+				return err
+			})
+		case id == 2 && typ == thriftI64:
+			g.totalByteSize, err = r.zigzag()
+		case id == 3 && typ == thriftI64:
+			g.numRows, err = r.zigzag()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return g, err
+}
+
+func readColumnChunk(r *compactReader) (columnChunk, error) {
+	var c columnChunk
+	hasMeta := false
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftBinary:
+			var path string
+			if path, err = r.str(); err == nil && path != "" {
+				err = fmt.Errorf("column chunks in external files (%s) are not supported", path)
+			}
+		case id == 2 && typ == thriftI64:
+			c.fileOffset, err = r.zigzag()
+		case id == 3 && typ == thriftStruct:
+			hasMeta = true
+			c.meta, err = readColumnMetaData(r)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err == nil && !hasMeta {
+		err = fmt.Errorf("column chunk without metadata")
+	}
+	return c, err
+}
+
+func readColumnMetaData(r *compactReader) (columnMetaData, error) {
+	var m columnMetaData
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.physical, err = r.i32()
+		case id == 2 && typ == thriftList:
+			err = readList(r, func() error {
+				e, err := r.i32()
+				m.encodings = append(m.encodings, e)
+				return err
+			})
+		case id == 3 && typ == thriftList:
+			err = readList(r, func() error {
+				p, err := r.str()
+				m.path = append(m.path, p)
+				return err
+			})
+		case id == 4 && typ == thriftI32:
+			m.codec, err = r.i32()
+		case id == 5 && typ == thriftI64:
+			m.numValues, err = r.zigzag()
+		case id == 6 && typ == thriftI64:
+			m.totalUncompressedSize, err = r.zigzag()
+		case id == 7 && typ == thriftI64:
+			m.totalCompressedSize, err = r.zigzag()
+		case id == 9 && typ == thriftI64:
+			m.dataPageOffset, err = r.zigzag()
+		case id == 11 && typ == thriftI64:
+			m.dictionaryPageOffset, err = r.zigzag()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return m, err
+}
+
+func readPageHeader(r *compactReader) (pageHeader, error) {
+	h := pageHeader{dataV2: dataPageHeaderV2{isCompressed: true}}
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.typ, err = r.i32()
+		case id == 2 && typ == thriftI32:
+			h.uncompressedSize, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			h.compressedSize, err = r.i32()
+		case id == 5 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				return readI32Fields(r, id, typ, map[int16]*int32{
+					1: &h.data.numValues, 2: &h.data.encoding, 3: &h.data.defLevelEncoding,
+				})
+			})
+		case id == 7 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				return readI32Fields(r, id, typ, map[int16]*int32{
+					1: &h.dictionary.numValues, 2: &h.dictionary.encoding,
+				})
+			})
+		case id == 8 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				if id == 7 && (typ == thriftTrue || typ == thriftFalse) {
+					h.dataV2.isCompressed = typ == thriftTrue
+					return nil
+				}
+				return readI32Fields(r, id, typ, map[int16]*int32{
+					1: &h.dataV2.numValues, 2: &h.dataV2.numNulls, 4: &h.dataV2.encoding,
+					5: &h.dataV2.defLength, 6: &h.dataV2.repLength,
+				})
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return h, err
+}
+
+// readI32Fields stores an i32 field in its destination when the id is listed, and skips it otherwise.
+func readI32Fields(r *compactReader, id int16, typ byte, fields map[int16]*int32) error {
+	dst, ok := fields[id]
+	if !ok || typ != thriftI32 {
+		return r.skip(typ)
+	}
+	v, err := r.i32()
+	*dst = v
+	return err
+}
+
+func writeFileMetaData(w *compactWriter, m fileMetaData) {
+	w.beginStruct()
+	w.i32Field(1, m.version)
+	w.listField(2, thriftStruct, len(m.schema))
+	for _, e := range m.schema {
+		writeSchemaElement(w, e)
+	}
+	w.i64Field(3, m.numRows)
+	w.listField(4, thriftStruct, len(m.rowGroups))
+	for _, g := range m.rowGroups {
+		writeRowGroup(w, g)
+	}
+	if m.createdBy != "" {
+		w.stringField(6, m.createdBy)
+	}
+	w.endStruct()
+}
+
+func writeSchemaElement(w *compactWriter, e schemaElement) {
+	w.beginStruct()
+	if e.physical >= 0 {
+		w.i32Field(1, e.physical)
+	}
+	// the root has no repetition, groups are required when it is absent
+	if e.physical >= 0 || e.repetition != repetitionRequired {
+		w.i32Field(3, e.repetition)
+	}
+	w.stringField(4, e.name)
+	if e.physical < 0 {
+		w.i32Field(5, e.numChildren)
+	}
+	if e.convertedType >= 0 {
+		w.i32Field(6, e.convertedType)
+	}
+	if e.logical.kind != 0 {
+		w.structField(10)
+		w.structField(e.logical.kind)
+		if e.logical.kind == logicalTimestamp {
+			w.boolField(1, e.logical.adjusted)
+			w.structField(2)
+			w.structField(e.logical.unit)
+			w.endStruct()
+			w.endStruct()
+		}
+		w.endStruct()
+		w.endStruct()
+	}
+	w.endStruct()
+}
+
+func writeRowGroup(w *compactWriter, g rowGroup) {
+	w.beginStruct()
+	w.listField(1, thriftStruct, len(g.columns))
+	for _, c := range g.columns {
+		w.beginStruct()
+		w.i64Field(2, c.fileOffset)
+		w.structField(3)
+		writeColumnMetaData(w, c.meta)
+		w.endStruct()
+		w.endStruct()
+	}
+	w.i64Field(2, g.totalByteSize)
+	w.i64Field(3, g.numRows)
+	w.endStruct()
+}
+
+func writeColumnMetaData(w *compactWriter, m columnMetaData) {
+	w.i32Field(1, m.physical)
+	w.listField(2, thriftI32, len(m.encodings))
+	for _, e := range m.encodings {
+		w.zigzag(int64(e))
+	}
+	w.listField(3, thriftBinary, len(m.path))
+	for _, p := range m.path {
+		w.binary([]byte(p))
+	}
+	w.i32Field(4, m.codec)
+	w.i64Field(5, m.numValues)
+	w.i64Field(6, m.totalUncompressedSize)
+	w.i64Field(7, m.totalCompressedSize)
+	w.i64Field(9, m.dataPageOffset)
+	if m.dictionaryPageOffset > 0 {
+		w.i64Field(11, m.dictionaryPageOffset)
+	}
+}
+
+func writePageHeader(w *compactWriter, h pageHeader) {
+	w.beginStruct()
+	w.i32Field(1, h.typ)
+	w.i32Field(2, h.uncompressedSize)
+	w.i32Field(3, h.compressedSize)
+	switch h.typ {
+	case pageData:
+		w.structField(5)
+		w.i32Field(1, h.data.numValues)
+		w.i32Field(2, h.data.encoding)
+		w.i32Field(3, h.data.defLevelEncoding)
+		w.i32Field(4, encodingRLE)
+		w.endStruct()
+	case pageDictionary:
+		w.structField(7)
+		w.i32Field(1, h.dictionary.numValues)
+		w.i32Field(2, h.dictionary.encoding)
+		w.endStruct()
+	case pageDataV2:
+		w.structField(8)
+		w.i32Field(1, h.dataV2.numValues)
+		w.i32Field(2, h.dataV2.numNulls)
+		w.i32Field(3, h.dataV2.numValues)
+		w.i32Field(4, h.dataV2.encoding)
+		w.i32Field(5, h.dataV2.defLength)
+		w.i32Field(6, h.dataV2.repLength)
+		w.boolField(7, h.dataV2.isCompressed)
+		w.endStruct()
+	}
+	w.endStruct()
+}
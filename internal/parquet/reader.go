@@ -0,0 +1,481 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"time"
+)
+
+// magic starts and ends every Parquet file.
+const magic = "PAR1"
+
+// julianUnixEpoch is the Julian day of 1970-01-01, used by INT96 timestamps.
+const julianUnixEpoch = 2440588
+
+// Field describes a column of a Parquet file.
+type Field struct {
+	Name string // column path, the names of nested groups joined with "."
+	Type string // series type tag of the decoded values
+}
+
+// File is an open Parquet file. Its row groups can be read one column at a time.
+type File struct {
+	r      io.ReaderAt
+	size   int64
+	meta   fileMetaData
+	leaves []leaf
+}
+
+// leaf is a primitive column of the schema.
+type leaf struct {
+	Field
+	element schemaElement
+	maxDef  int32
+	err     error // why the column cannot be read, if it cannot
+}
+
+// Open reads the footer of a Parquet file of the given size.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New("parquet: file too small")
+	}
+	tail := make([]byte, 8)
+	if _, err := r.ReadAt(tail, size-8); err != nil {
+		return nil, fmt.Errorf("parquet: %w", err)
+	}
+	head := make([]byte, len(magic))
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return nil, fmt.Errorf("parquet: %w", err)
+	}
+	if string(tail[4:]) != magic || string(head) != magic {
+		return nil, errors.New("parquet: not a Parquet file")
+	}
+	footerSize := int64(binary.LittleEndian.Uint32(tail))
+	if footerSize > size-int64(2*len(magic)+4) {
+		return nil, errors.New("parquet: invalid footer size")
+	}
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-8-footerSize); err != nil {
+		return nil, fmt.Errorf("parquet: %w", err)
+	}
+	meta, err := readFileMetaData(&compactReader{buf: footer})
+	if err != nil {
+		return nil, fmt.Errorf("parquet: footer: %w", err)
+	}
+	leaves, err := schemaLeaves(meta.schema)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: schema: %w", err)
+	}
+	return &File{r: r, size: size, meta: meta, leaves: leaves}, nil
+}
+
+// schemaLeaves flattens the schema tree into its primitive columns.
+func schemaLeaves(schema []schemaElement) ([]leaf, error) {
+	if len(schema) == 0 {
+		return nil, errors.New("empty schema")
+	}
+	var leaves []leaf
+	var walk func(pos int, prefix string, def int32, repeated bool) (int, error)
+	walk = func(pos int, prefix string, def int32, repeated bool) (int, error) {
+		if pos >= len(schema) {
+			return 0, errors.New("truncated schema")
+		}
+		e := schema[pos]
+		name := e.name
+		if prefix != "" {
+			name = prefix + "." + e.name
+		}
+		switch e.repetition {
+		case repetitionOptional:
+			def++
+		case repetitionRepeated:
+			repeated = true
+		}
+		if e.physical < 0 {
+			next := pos + 1
+			for range e.numChildren {
+				var err error
+				if next, err = walk(next, name, def, repeated); err != nil {
+					return 0, err
+				}
+			}
+			return next, nil
+		}
+		l := leaf{Field: Field{Name: name}, element: e, maxDef: def}
+		l.Type, l.err = columnType(e)
+		if repeated {
+			l.err = errors.New("repeated fields are not supported")
+		}
+		leaves = append(leaves, l)
+		return pos + 1, nil
+	}
+	next := 1
+	for range schema[0].numChildren {
+		var err error
+		if next, err = walk(next, "", 0, false); err != nil {
+			return nil, err
+		}
+	}
+	return leaves, nil
+}
+
+// columnType returns the series type tag used for the values of a primitive column.
+func columnType(e schemaElement) (string, error) {
+	_, decimal := decimalScale(e)
+	switch e.physical {
+	case typeBoolean:
+		return "bool", nil
+	case typeInt32:
+		if e.logical.kind == logicalDate || e.convertedType == convertedDate {
+			return "date", nil
+		}
+	case typeInt64:
+		if timestampUnit(e) != 0 {
+			return "date", nil
+		}
+	case typeInt96:
+		return "date", nil
+	case typeFloat, typeDouble:
+		return "float", nil
+	case typeByteArray, typeFixedLenByteArray:
+		if !decimal {
+			return "string", nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported physical type %d", e.physical)
+	}
+	if decimal {
+		return "float", nil
+	}
+	return "number", nil
+}
+
+// timestampUnit returns the time unit of a timestamp column, 0 for other columns.
+func timestampUnit(e schemaElement) int16 {
+	switch {
+	case e.logical.kind == logicalTimestamp:
+		return e.logical.unit
+	case e.convertedType == convertedTimestampMillis:
+		return unitMillis
+	case e.convertedType == convertedTimestampMicros:
+		return unitMicros
+	}
+	return 0
+}
+
+// decimalScale returns the scale of a decimal column and whether the column is a decimal.
+func decimalScale(e schemaElement) (int32, bool) {
+	switch {
+	case e.logical.kind == logicalDecimal:
+		return e.logical.scale, true
+	case e.convertedType == convertedDecimal:
+		return e.scale, true
+	}
+	return 0, false
+}
+
+// Fields returns the primitive columns of the file in schema order.
+func (f *File) Fields() []Field {
+	out := make([]Field, len(f.leaves))
+	for i, l := range f.leaves {
+		out[i] = l.Field
+	}
+	return out
+}
+
+// NumRows returns the number of rows of the file.
+func (f *File) NumRows() int64 {
+	return f.meta.numRows
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (f *File) NumRowGroups() int {
+	return len(f.meta.rowGroups)
+}
+
+// RowGroupRows returns the number of rows of a row group.
+func (f *File) RowGroupRows(rowGroup int) int64 {
+	return f.meta.rowGroups[rowGroup].numRows
+}
+
+// ReadColumn decodes a column of a row group. Values are int for "number", float64 for
+// "float", bool for "bool", string for "string" and time.Time (UTC) for "date"; nulls are nil.
+func (f *File) ReadColumn(rowGroup int, column int) ([]any, error) {
+	if rowGroup < 0 || rowGroup >= len(f.meta.rowGroups) {
+		return nil, fmt.Errorf("parquet: row group %d out of range", rowGroup)
+	}
+	if column < 0 || column >= len(f.leaves) {
+		return nil, fmt.Errorf("parquet: column %d out of range", column)
+	}
+	l := &f.leaves[column]
+	if l.err != nil {
+		return nil, fmt.Errorf("parquet: column %q: %w", l.Name, l.err)
+	}
+	g := f.meta.rowGroups[rowGroup]
+	if len(g.columns) != len(f.leaves) {
+		return nil, fmt.Errorf("parquet: row group %d has %d columns, expected %d", rowGroup, len(g.columns), len(f.leaves))
+	}
+	meta := g.columns[column].meta
+	start := meta.dataPageOffset
+	if meta.dictionaryPageOffset > 0 && meta.dictionaryPageOffset < start {
+		start = meta.dictionaryPageOffset
+	}
+	if start < 0 || meta.totalCompressedSize < 0 || start+meta.totalCompressedSize > f.size {
+		return nil, fmt.Errorf("parquet: column %q: invalid column chunk bounds", l.Name)
+	}
+	chunk := make([]byte, meta.totalCompressedSize)
+	if n, err := f.r.ReadAt(chunk, start); n < len(chunk) {
+		return nil, fmt.Errorf("parquet: column %q: %w", l.Name, err)
+	}
+	values, err := l.readChunk(chunk, meta)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: column %q: row group %d: %w", l.Name, rowGroup, err)
+	}
+	if int64(len(values)) != g.numRows {
+		return nil, fmt.Errorf("parquet: column %q: row group %d: %d values for %d rows", l.Name, rowGroup, len(values), g.numRows)
+	}
+	return values, nil
+}
+
+// readChunk decodes the pages of a column chunk.
+func (l *leaf) readChunk(chunk []byte, meta columnMetaData) ([]any, error) {
+	var values, dict []any
+	r := &compactReader{buf: chunk}
+	for int64(len(values)) < meta.numValues {
+		if r.pos >= len(chunk) {
+			return nil, fmt.Errorf("chunk ends after %d of %d values", len(values), meta.numValues)
+		}
+		h, err := readPageHeader(r)
+		if err != nil {
+			return nil, fmt.Errorf("page header: %w", err)
+		}
+		if h.compressedSize < 0 || h.uncompressedSize < 0 || int(h.compressedSize) > len(chunk)-r.pos {
+			return nil, errTruncated
+		}
+		payload := chunk[r.pos : r.pos+int(h.compressedSize)]
+		r.pos += int(h.compressedSize)
+		switch h.typ {
+		case pageDictionary:
+			data, err := decompress(meta.codec, payload, int(h.uncompressedSize))
+			if err != nil {
+				return nil, err
+			}
+			if h.dictionary.encoding != encodingPlain && h.dictionary.encoding != encodingPlainDictionary {
+				return nil, fmt.Errorf("unsupported dictionary encoding %d", h.dictionary.encoding)
+			}
+			if dict, err = l.decodePlain(data, int(h.dictionary.numValues)); err != nil {
+				return nil, err
+			}
+		case pageData:
+			data, err := decompress(meta.codec, payload, int(h.uncompressedSize))
+			if err != nil {
+				return nil, err
+			}
+			count := int(h.data.numValues)
+			var defs []int32
+			if l.maxDef > 0 {
+				if h.data.defLevelEncoding != encodingRLE {
+					return nil, fmt.Errorf("unsupported definition level encoding %d", h.data.defLevelEncoding)
+				}
+				var n int
+				if defs, n, err = decodeLengthPrefixedHybrid(data, bitWidth(int(l.maxDef)), count); err != nil {
+					return nil, err
+				}
+				data = data[n:]
+			}
+			if values, err = l.decodePage(values, defs, count, h.data.encoding, data, dict); err != nil {
+				return nil, err
+			}
+		case pageDataV2:
+			v2 := h.dataV2
+			levels := int(v2.repLength) + int(v2.defLength)
+			if v2.repLength < 0 || v2.defLength < 0 || levels > len(payload) {
+				return nil, errTruncated
+			}
+			count := int(v2.numValues)
+			var defs []int32
+			if l.maxDef > 0 {
+				if defs, _, err = decodeHybrid(payload[v2.repLength:levels], bitWidth(int(l.maxDef)), count); err != nil {
+					return nil, err
+				}
+			}
+			data := payload[levels:]
+			if v2.isCompressed {
+				if data, err = decompress(meta.codec, data, int(h.uncompressedSize)-levels); err != nil {
+					return nil, err
+				}
+			}
+			if values, err = l.decodePage(values, defs, count, v2.encoding, data, dict); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+// decodePage appends the count values of a data page to values, using the definition levels
+// to place nulls.
+func (l *leaf) decodePage(values []any, defs []int32, count int, encoding int32, data []byte, dict []any) ([]any, error) {
+	present := count
+	if defs != nil {
+		present = 0
+		for _, d := range defs {
+			if d == l.maxDef {
+				present++
+			}
+		}
+	}
+	var decoded []any
+	var err error
+	switch encoding {
+	case encodingPlain:
+		decoded, err = l.decodePlain(data, present)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dict == nil {
+			return nil, errors.New("dictionary encoded page without dictionary")
+		}
+		if len(data) == 0 {
+			return nil, errTruncated
+		}
+		var indices []int32
+		if indices, _, err = decodeHybrid(data[1:], int(data[0]), present); err != nil {
+			return nil, err
+		}
+		decoded = make([]any, present)
+		for i, idx := range indices {
+			if idx < 0 || int(idx) >= len(dict) {
+				return nil, fmt.Errorf("dictionary index %d out of range", idx)
+			}
+			decoded[i] = dict[idx]
+		}
+	case encodingRLE:
+		if l.element.physical != typeBoolean {
+			return nil, fmt.Errorf("unsupported RLE encoding for physical type %d", l.element.physical)
+		}
+		var bits []int32
+		if bits, _, err = decodeLengthPrefixedHybrid(data, 1, present); err != nil {
+			return nil, err
+		}
+		decoded = make([]any, present)
+		for i, b := range bits {
+			decoded[i] = b == 1
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encoding %d", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	for i := range count {
+		if defs != nil && defs[i] != l.maxDef {
+			values = append(values, nil)
+			continue
+		}
+		values = append(values, decoded[next])
+		next++
+	}
+	return values, nil
+}
+
+// decodePlain decodes PLAIN values and converts them to their series representation.
+func (l *leaf) decodePlain(data []byte, count int) ([]any, error) {
+	raw, err := decodePlain(data, l.element.physical, int(l.element.typeLength), count)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range raw {
+		raw[i] = l.convert(v)
+	}
+	return raw, nil
+}
+
+// convert converts a physical value to the canonical Go value of the column type tag.
+func (l *leaf) convert(v any) any {
+	e := l.element
+	scale, decimal := decimalScale(e)
+	switch v := v.(type) {
+	case int32:
+		switch {
+		case l.Type == "date":
+			return time.Unix(int64(v)*86400, 0).UTC()
+		case decimal:
+			return float64(v) / math.Pow10(int(scale))
+		}
+		return int(v)
+	case int64:
+		switch {
+		case e.physical == typeInt64 && l.Type == "date":
+			switch timestampUnit(e) {
+			case unitMillis:
+				return time.UnixMilli(v).UTC()
+			case unitMicros:
+				return time.UnixMicro(v).UTC()
+			}
+			return time.Unix(0, v).UTC()
+		case decimal:
+			return float64(v) / math.Pow10(int(scale))
+		}
+		return int(v)
+	case float32:
+		return float64(v)
+	case []byte:
+		switch {
+		case e.physical == typeInt96 && len(v) == 12:
+			nanos := int64(binary.LittleEndian.Uint64(v))
+			days := int64(binary.LittleEndian.Uint32(v[8:])) - julianUnixEpoch
+			return time.Unix(days*86400, nanos).UTC()
+		case decimal:
+			// big-endian two's complement unscaled value
+			n := new(big.Int).SetBytes(v)
+			if len(v) > 0 && v[0]&0x80 != 0 {
+				n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*len(v))))
+			}
+			f, _ := new(big.Float).Quo(new(big.Float).SetInt(n), new(big.Float).SetFloat64(math.Pow10(int(scale)))).Float64()
+			return f
+		case e.logical.kind == logicalUUID && len(v) == 16:
+			return fmt.Sprintf("%x-%x-%x-%x-%x", v[:4], v[4:6], v[6:8], v[8:10], v[10:])
+		}
+		return string(v)
+	}
+	return v
+}
+
+// codecNames names the compression codecs that cannot be read, for error messages.
+var codecNames = map[int32]string{3: "LZO", 4: "BROTLI", 5: "LZ4", 6: "ZSTD", 7: "LZ4_RAW"}
+
+// decompress decompresses a page of the given uncompressed size.
+func decompress(codec int32, data []byte, size int) ([]byte, error) {
+	var out []byte
+	var err error
+	switch codec {
+	case codecUncompressed:
+		out = data
+	case codecSnappy:
+		out, err = snappyDecode(data)
+	case codecGzip:
+		var zr *gzip.Reader
+		if zr, err = gzip.NewReader(bytes.NewReader(data)); err == nil {
+			out, err = io.ReadAll(io.LimitReader(zr, int64(size)+1))
+		}
+	default:
+		name, ok := codecNames[codec]
+		if !ok {
+			name = fmt.Sprint(codec)
+		}
+		return nil, fmt.Errorf("unsupported compression codec %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(out) != size {
+		return nil, fmt.Errorf("page decompressed to %d bytes, expected %d", len(out), size)
+	}
+	return out, nil
+}
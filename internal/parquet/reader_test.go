@@ -0,0 +1,178 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// page encodes a page header followed by its body.
+func page(h pageHeader, body []byte) []byte {
+	w := &compactWriter{}
+	writePageHeader(w, h)
+	return append(w.buf.Bytes(), body...)
+}
+
+// levels encodes definition levels of a v1 data page, prefixed by their length.
+func levels(defs ...int32) []byte {
+	encoded := encodeHybrid(defs, 1)
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(encoded))), encoded...)
+}
+
+// buildFile assembles a file with a single row group holding a chunk per leaf column,
+// compressed with the codec of the same index.
+func buildFile(schema []schemaElement, numRows int64, codecs []int32, chunks ...[]byte) []byte {
+	file := []byte(magic)
+	group := rowGroup{numRows: numRows}
+	leaf := 0
+	for _, e := range schema {
+		if e.physical < 0 {
+			continue
+		}
+		meta := columnMetaData{physical: e.physical, codec: codecs[leaf], path: []string{e.name}, numValues: numRows,
+			dataPageOffset: int64(len(file)), totalCompressedSize: int64(len(chunks[leaf]))}
+		group.columns = append(group.columns, columnChunk{fileOffset: meta.dataPageOffset, meta: meta})
+		file = append(file, chunks[leaf]...)
+		leaf++
+	}
+	w := &compactWriter{}
+	writeFileMetaData(w, fileMetaData{version: 1, schema: schema, numRows: numRows, rowGroups: []rowGroup{group}})
+	file = append(file, w.buf.Bytes()...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(w.buf.Len()))
+	return append(file, magic...)
+}
+
+func TestOpen(t *testing.T) {
+	// a dictionary encoded string nested in an optional group, a v2 page of dates compressed
+	// with snappy and a required INT96 timestamp
+	schema := []schemaElement{
+		{name: "schema", physical: -1, convertedType: -1, numChildren: 3},
+		{name: "user", physical: -1, convertedType: -1, repetition: repetitionOptional, numChildren: 1},
+		{name: "name", physical: typeByteArray, convertedType: convertedUTF8, repetition: repetitionRequired},
+		{name: "day", physical: typeInt32, convertedType: convertedDate, repetition: repetitionOptional},
+		{name: "at", physical: typeInt96, convertedType: -1, repetition: repetitionRequired},
+	}
+
+	dict := encodePlain(nil, []any{[]byte("a"), []byte("b")}, typeByteArray)
+	indices := append([]byte{1}, encodeHybrid([]int32{1, 0, 1}, 1)...)
+	names := append(
+		page(pageHeader{typ: pageDictionary, uncompressedSize: int32(len(dict)), compressedSize: int32(len(dict)),
+			dictionary: dictionaryPageHeader{numValues: 2, encoding: encodingPlainDictionary}}, dict),
+		page(pageHeader{typ: pageData, uncompressedSize: int32(len(levels(1, 0, 1, 1)) + len(indices)),
+			compressedSize: int32(len(levels(1, 0, 1, 1)) + len(indices)),
+			data:           dataPageHeader{numValues: 4, encoding: encodingRLEDictionary, defLevelEncoding: encodingRLE}},
+			append(levels(1, 0, 1, 1), indices...))...,
+	)
+
+	defs := encodeHybrid([]int32{1, 0, 0, 1}, 1)
+	days := binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, 19000), 0)
+	compressed := snappyEncode(days)
+	dates := page(pageHeader{typ: pageDataV2, uncompressedSize: int32(len(defs) + len(days)), compressedSize: int32(len(defs) + len(compressed)),
+		dataV2: dataPageHeaderV2{numValues: 4, numNulls: 2, encoding: encodingPlain, defLength: int32(len(defs)), isCompressed: true}},
+		append(defs, compressed...))
+
+	var int96 []byte
+	for day := range 4 {
+		int96 = binary.LittleEndian.AppendUint64(int96, uint64(time.Hour))
+		int96 = binary.LittleEndian.AppendUint32(int96, uint32(julianUnixEpoch+day))
+	}
+	timestamps := page(pageHeader{typ: pageData, uncompressedSize: int32(len(int96)), compressedSize: int32(len(int96)),
+		data: dataPageHeader{numValues: 4, encoding: encodingPlain, defLevelEncoding: encodingRLE}}, int96)
+
+	file := buildFile(schema, 4, []int32{codecUncompressed, codecSnappy, codecUncompressed}, names, dates, timestamps)
+	f, err := Open(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expectedFields := []Field{{"user.name", "string"}, {"day", "date"}, {"at", "date"}}
+	if !is.SameSlice(f.Fields(), expectedFields) {
+		t.Errorf("expected fields %v, got %v", expectedFields, f.Fields())
+	}
+	epoch := time.Unix(0, 0).UTC()
+	expected := [][]any{
+		{"b", nil, "a", "b"},
+		{time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), nil, nil, epoch},
+		{epoch.Add(time.Hour), epoch.Add(25 * time.Hour), epoch.Add(49 * time.Hour), epoch.Add(73 * time.Hour)},
+	}
+	for i, values := range expected {
+		got, err := f.ReadColumn(0, i)
+		if err != nil {
+			t.Fatalf("column %d: unexpected error %v", i, err)
+		}
+		if !is.Equal(got, values) {
+			t.Errorf("column %d: expected %v, got %v", i, values, got)
+		}
+	}
+}
+
+func TestOpen_errors(t *testing.T) {
+	repeated := buildFile([]schemaElement{
+		{name: "schema", physical: -1, convertedType: -1, numChildren: 1},
+		{name: "tags", physical: typeByteArray, convertedType: -1, repetition: repetitionRepeated},
+	}, 0, []int32{codecUncompressed}, nil)
+	zstd := buildFile([]schemaElement{
+		{name: "schema", physical: -1, convertedType: -1, numChildren: 1},
+		{name: "n", physical: typeInt64, convertedType: -1, repetition: repetitionRequired},
+	}, 1, []int32{6}, page(pageHeader{typ: pageData, uncompressedSize: 8, compressedSize: 8}, make([]byte, 8)))
+	truncated := buildFile([]schemaElement{
+		{name: "schema", physical: -1, convertedType: -1, numChildren: 1},
+		{name: "n", physical: typeInt64, convertedType: -1, repetition: repetitionRequired},
+	}, 2, []int32{codecUncompressed}, page(pageHeader{typ: pageData, uncompressedSize: 8, compressedSize: 8,
+		data: dataPageHeader{numValues: 2}}, make([]byte, 8)))
+
+	testCases := []struct {
+		name    string
+		file    []byte
+		wantErr string
+	}{
+		{"not parquet", []byte("hello, world!"), "not a Parquet file"},
+		{"too small", []byte("PAR1PAR1"), "file too small"},
+		{"repeated", repeated, `column "tags": repeated fields are not supported`},
+		{"codec", zstd, "unsupported compression codec ZSTD"},
+		{"truncated", truncated, "unexpected end of data"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			f, err := Open(bytes.NewReader(tc.file), int64(len(tc.file)))
+			if err == nil {
+				_, err = f.ReadColumn(0, 0)
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLeaf_convert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		element  schemaElement
+		value    any
+		expected any
+	}{
+		{"int32", schemaElement{physical: typeInt32, convertedType: -1}, int32(-7), -7},
+		{"int32 decimal", schemaElement{physical: typeInt32, convertedType: convertedDecimal, scale: 2}, int32(1234), 12.34},
+		{"int64 decimal", schemaElement{physical: typeInt64, convertedType: -1, logical: logicalType{kind: logicalDecimal, scale: 3}}, int64(-1500), -1.5},
+		{"fixed decimal", schemaElement{physical: typeFixedLenByteArray, convertedType: convertedDecimal, scale: 1}, []byte{0xff, 0x85}, -12.3},
+		{"millis", schemaElement{physical: typeInt64, convertedType: convertedTimestampMillis}, int64(86400000), time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"nanos", schemaElement{physical: typeInt64, convertedType: -1, logical: logicalType{kind: logicalTimestamp, unit: unitNanos}}, int64(1500), time.Unix(0, 1500).UTC()},
+		{"float", schemaElement{physical: typeFloat, convertedType: -1}, float32(0.5), 0.5},
+		{"uuid", schemaElement{physical: typeFixedLenByteArray, convertedType: -1, logical: logicalType{kind: logicalUUID}},
+			[]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}, "12345678-9abc-def0-1234-56789abcdef0"},
+		{"binary", schemaElement{physical: typeByteArray, convertedType: -1}, []byte("raw"), "raw"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			l := leaf{element: tc.element}
+			l.Type, _ = columnType(tc.element)
+			if got := l.convert(tc.value); !is.EqualWithin(got, tc.expected, 1e-9) {
+				tt.Errorf("expected %v (%T), got %v (%T)", tc.expected, tc.expected, got, got)
+			}
+		})
+	}
+}
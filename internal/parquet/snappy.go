@@ -0,0 +1,162 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Snappy block format (https://github.com/google/snappy/blob/main/format_description.txt):
+// the uncompressed length as a varint, then a sequence of literals and back-references.
+const (
+	snappyLiteral = 0
+	snappyCopy1   = 1
+	snappyCopy2   = 2
+	snappyCopy4   = 3
+
+	snappyBlockSize = 1 << 16
+	snappyHashBits  = 14
+)
+
+var errSnappyCorrupt = errors.New("snappy: corrupt input")
+
+// snappyDecode decompresses a snappy block.
+func snappyDecode(src []byte) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	if n <= 0 || length > 1<<32 {
+		return nil, errSnappyCorrupt
+	}
+	// a tag byte expands to at most 64 bytes, which bounds the output size
+	if length > uint64(len(src))*64 {
+		return nil, errSnappyCorrupt
+	}
+	dst := make([]byte, 0, length)
+	s := n
+	for s < len(src) {
+		tag := src[s]
+		s++
+		var offset, size int
+		switch tag & 3 {
+		case snappyLiteral:
+			size = int(tag >> 2)
+			if size >= 60 {
+				extra := size - 59
+				if s+extra > len(src) {
+					return nil, errSnappyCorrupt
+				}
+				size = 0
+				for i := range extra {
+					size |= int(src[s+i]) << (8 * i)
+				}
+				s += extra
+			}
+			size++
+			if size <= 0 || s+size > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			dst = append(dst, src[s:s+size]...)
+			s += size
+			continue
+		case snappyCopy1:
+			if s >= len(src) {
+				return nil, errSnappyCorrupt
+			}
+			size = 4 + int(tag>>2&7)
+			offset = int(tag>>5)<<8 | int(src[s])
+			s++
+		case snappyCopy2:
+			if s+2 > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			size = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[s:]))
+			s += 2
+		case snappyCopy4:
+			if s+4 > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			size = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[s:]))
+			s += 4
+		}
+		if offset <= 0 || offset > len(dst) || uint64(len(dst)+size) > length {
+			return nil, errSnappyCorrupt
+		}
+		// copies may overlap their own output, so copy byte by byte
+		start := len(dst) - offset
+		for i := range size {
+			dst = append(dst, dst[start+i])
+		}
+	}
+	if uint64(len(dst)) != length {
+		return nil, errSnappyCorrupt
+	}
+	return dst, nil
+}
+
+// snappyEncode compresses src as a snappy block. Matches are searched with a hash table of
+// 4-byte sequences, in independent blocks of 64 KiB.
+func snappyEncode(src []byte) []byte {
+	dst := binary.AppendUvarint(nil, uint64(len(src)))
+	for len(src) > 0 {
+		block := src[:min(len(src), snappyBlockSize)]
+		dst = snappyEncodeBlock(dst, block)
+		src = src[len(block):]
+	}
+	return dst
+}
+
+func snappyEncodeBlock(dst []byte, src []byte) []byte {
+	var table [1 << snappyHashBits]int
+	literal := 0
+	for s := 0; s+4 <= len(src); {
+		seq := binary.LittleEndian.Uint32(src[s:])
+		h := (seq * 0x1e35a7bd) >> (32 - snappyHashBits)
+		candidate := table[h]
+		table[h] = s
+		if candidate >= s || binary.LittleEndian.Uint32(src[candidate:]) != seq {
+			s++
+			continue
+		}
+		size := 4
+		for s+size < len(src) && src[candidate+size] == src[s+size] {
+			size++
+		}
+		dst = snappyEmitLiteral(dst, src[literal:s])
+		dst = snappyEmitCopy(dst, s-candidate, size)
+		s += size
+		literal = s
+	}
+	return snappyEmitLiteral(dst, src[literal:])
+}
+
+func snappyEmitLiteral(dst []byte, lit []byte) []byte {
+	n := len(lit) - 1
+	switch {
+	case len(lit) == 0:
+		return dst
+	case n < 60:
+		dst = append(dst, byte(n)<<2|snappyLiteral)
+	case n < 1<<8:
+		dst = append(dst, 60<<2|snappyLiteral, byte(n))
+	default:
+		// blocks are at most 64 KiB long
+		dst = append(dst, 61<<2|snappyLiteral, byte(n), byte(n>>8))
+	}
+	return append(dst, lit...)
+}
+
+func snappyEmitCopy(dst []byte, offset int, size int) []byte {
+	for size >= 68 {
+		dst = append(dst, 63<<2|snappyCopy2, byte(offset), byte(offset>>8))
+		size -= 64
+	}
+	if size > 64 {
+		// leave at least 4 bytes for the last copy
+		dst = append(dst, 59<<2|snappyCopy2, byte(offset), byte(offset>>8))
+		size -= 60
+	}
+	if size < 12 && offset < 2048 {
+		return append(dst, byte(offset>>8)<<5|byte(size-4)<<2|snappyCopy1, byte(offset))
+	}
+	return append(dst, byte(size-1)<<2|snappyCopy2, byte(offset), byte(offset>>8))
+}
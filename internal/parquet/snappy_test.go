@@ -0,0 +1,83 @@
+package parquet
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestSnappyRoundTrip(t *testing.T) {
+	random := make([]byte, 200000)
+	rand.New(rand.NewSource(1)).Read(random)
+	testCases := []struct {
+		name  string
+		input []byte
+	}{
+		{"empty", []byte{}},
+		{"short", []byte("abc")},
+		{"repetitive", bytes.Repeat([]byte("go-starter "), 10000)},
+		{"long run", bytes.Repeat([]byte{0}, 100000)},
+		{"random", random},
+		{"mixed", append(bytes.Repeat([]byte("abcdefgh"), 300), random[:5000]...)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			encoded := snappyEncode(tc.input)
+			decoded, err := snappyDecode(encoded)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if !bytes.Equal(decoded, tc.input) {
+				tt.Errorf("round trip changed the input")
+			}
+		})
+	}
+	if n := len(snappyEncode(bytes.Repeat([]byte("go-starter "), 10000))); n > 10000 {
+		t.Errorf("expected repetitive input to compress, got %d bytes", n)
+	}
+}
+
+func TestSnappyDecode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []byte
+		expected string
+		wantErr  bool
+	}{
+		// literal "ab", then a copy of 4 bytes at offset 2 (1-byte offset form)
+		{name: "literal and copy", input: []byte{6, 1 << 2, 'a', 'b', 0<<2 | 1, 2}, expected: "ababab"},
+		// literal "a", then a copy of 5 bytes at offset 1 (2-byte offset form)
+		{name: "overlapping copy", input: []byte{6, 0, 'a', 4<<2 | 2, 1, 0}, expected: "aaaaaa"},
+		{name: "offset before start", input: []byte{4, 0, 'a', 0<<2 | 1, 2}, wantErr: true},
+		{name: "wrong length", input: []byte{3, 0, 'a'}, wantErr: true},
+		{name: "truncated literal", input: []byte{3, 2 << 2, 'a'}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := snappyDecode(tc.input)
+			if (err != nil) != tc.wantErr {
+				tt.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if string(got) != tc.expected {
+				tt.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSnappyReference(t *testing.T) {
+	// the example of the snappy format description, as compressed by the reference encoder:
+	// a 67 byte literal, a copy of 6 bytes at offset 63 ("pedia ") and an 8 byte literal
+	text := "Wikipedia is a free, web-based, collaborative, multilingual encyclopedia project."
+	compressed := append(append(append([]byte{0x51, 0xf0, 0x42},
+		"Wikipedia is a free, web-based, collaborative, multilingual encyclo"...),
+		0x09, 0x3f, 0x1c), "project."...)
+
+	decoded, err := snappyDecode(compressed)
+	if err != nil || string(decoded) != text {
+		t.Fatalf("expected %q, got %q (%v)", text, decoded, err)
+	}
+	if encoded := snappyEncode([]byte(text)); !bytes.Equal(encoded, compressed) {
+		t.Errorf("expected the reference encoding %x, got %x", compressed, encoded)
+	}
+}
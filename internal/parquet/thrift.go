@@ -0,0 +1,280 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Thrift compact protocol type identifiers.
+const (
+	thriftStop   = 0
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+// maxThriftDepth bounds the nesting of skipped values to protect against malicious input.
+const maxThriftDepth = 64
+
+var errTruncated = errors.New("unexpected end of data")
+
+// compactReader decodes values of the thrift compact protocol from a byte slice.
+type compactReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *compactReader) readByte() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, errTruncated
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *compactReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	r.pos += n
+	return v, nil
+}
+
+// zigzag reads a zigzag encoded varint, used for i16, i32 and i64 values.
+func (r *compactReader) zigzag() (int64, error) {
+	v, err := r.varint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *compactReader) i32() (int32, error) {
+	v, err := r.zigzag()
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, fmt.Errorf("i32 out of range: %d", v)
+	}
+	return int32(v), err
+}
+
+func (r *compactReader) binary() ([]byte, error) {
+	n, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.buf)-r.pos) {
+		return nil, errTruncated
+	}
+	b := r.buf[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *compactReader) str() (string, error) {
+	b, err := r.binary()
+	return string(b), err
+}
+
+// listHeader reads the size and the element type of a list or a set.
+func (r *compactReader) listHeader() (int, byte, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := uint64(b >> 4)
+	if size == 15 {
+		if size, err = r.varint(); err != nil {
+			return 0, 0, err
+		}
+	}
+	// every element takes at least one byte, except booleans which also take one in lists
+	if size > uint64(len(r.buf)-r.pos) {
+		return 0, 0, errTruncated
+	}
+	return int(size), b & 0x0f, nil
+}
+
+// readStruct reads the fields of a struct until its stop marker, calling field for each of
+// them. field must consume the value or skip it with r.skip.
+func (r *compactReader) readStruct(field func(id int16, typ byte) error) error {
+	var last int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		typ := b & 0x0f
+		if typ == thriftStop {
+			return nil
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			v, err := r.zigzag()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		if err := field(id, typ); err != nil {
+			return err
+		}
+		last = id
+	}
+}
+
+// skip consumes a value of the given type.
+func (r *compactReader) skip(typ byte) error {
+	return r.skipDepth(typ, 0)
+}
+
+func (r *compactReader) skipDepth(typ byte, depth int) error {
+	if depth > maxThriftDepth {
+		return errors.New("thrift value nested too deeply")
+	}
+	var err error
+	switch typ {
+	case thriftTrue, thriftFalse:
+	case thriftByte:
+		_, err = r.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		_, err = r.varint()
+	case thriftDouble:
+		if len(r.buf)-r.pos < 8 {
+			return errTruncated
+		}
+		r.pos += 8
+	case thriftBinary:
+		_, err = r.binary()
+	case thriftList, thriftSet:
+		size, elem, err := r.listHeader()
+		if err != nil {
+			return err
+		}
+		for range size {
+			if elem == thriftTrue || elem == thriftFalse {
+				// booleans take a byte inside lists
+				if _, err := r.readByte(); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := r.skipDepth(elem, depth+1); err != nil {
+				return err
+			}
+		}
+	case thriftMap:
+		size, err := r.varint()
+		if err != nil || size == 0 {
+			return err
+		}
+		kinds, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		for range size {
+			if err := r.skipDepth(kinds>>4, depth+1); err != nil {
+				return err
+			}
+			if err := r.skipDepth(kinds&0x0f, depth+1); err != nil {
+				return err
+			}
+		}
+	case thriftStruct:
+		err = r.readStruct(func(_ int16, typ byte) error { return r.skipDepth(typ, depth+1) })
+	default:
+		err = fmt.Errorf("unknown thrift type %d", typ)
+	}
+	return err
+}
+
+// compactWriter encodes values with the thrift compact protocol.
+type compactWriter struct {
+	buf  bytes.Buffer
+	last []int16 // last field id of each open struct
+}
+
+func (w *compactWriter) varint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func (w *compactWriter) zigzag(v int64) {
+	w.varint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+// field writes a field header, using the short form when the id delta fits in 4 bits.
+func (w *compactWriter) field(id int16, typ byte) {
+	top := len(w.last) - 1
+	if delta := id - w.last[top]; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	w.last[top] = id
+}
+
+// beginStruct starts a struct, either at the top level or as a field or list element
+// whose header has already been written.
+func (w *compactWriter) beginStruct() {
+	w.last = append(w.last, 0)
+}
+
+func (w *compactWriter) endStruct() {
+	w.buf.WriteByte(thriftStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *compactWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.beginStruct()
+}
+
+func (w *compactWriter) boolField(id int16, v bool) {
+	if v {
+		w.field(id, thriftTrue)
+	} else {
+		w.field(id, thriftFalse)
+	}
+}
+
+func (w *compactWriter) i32Field(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *compactWriter) i64Field(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *compactWriter) binary(b []byte) {
+	w.varint(uint64(len(b)))
+	w.buf.Write(b)
+}
+
+func (w *compactWriter) stringField(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.binary([]byte(v))
+}
+
+// listField writes the header of a list field holding size elements of type elem.
+func (w *compactWriter) listField(id int16, elem byte, size int) {
+	w.field(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elem)
+		return
+	}
+	w.buf.WriteByte(0xf0 | elem)
+	w.varint(uint64(size))
+}
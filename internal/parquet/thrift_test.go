@@ -0,0 +1,78 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFileMetaDataRoundTrip(t *testing.T) {
+	meta := fileMetaData{
+		version:   1,
+		numRows:   3,
+		createdBy: "test",
+		schema: []schemaElement{
+			{name: "schema", physical: -1, convertedType: -1, numChildren: 2},
+			{name: "name", physical: typeByteArray, repetition: repetitionOptional, convertedType: convertedUTF8, logical: logicalType{kind: logicalString}},
+			{name: "at", physical: typeInt64, repetition: repetitionOptional, convertedType: convertedTimestampMicros,
+				logical: logicalType{kind: logicalTimestamp, unit: unitMicros, adjusted: true}},
+		},
+		rowGroups: []rowGroup{{
+			numRows:       3,
+			totalByteSize: 120,
+			columns: []columnChunk{
+				{fileOffset: 4, meta: columnMetaData{physical: typeByteArray, encodings: []int32{0, 3}, path: []string{"name"}, codec: codecSnappy,
+					numValues: 3, totalUncompressedSize: 60, totalCompressedSize: 50, dataPageOffset: 4}},
+				{fileOffset: 54, meta: columnMetaData{physical: typeInt64, encodings: []int32{0, 3}, path: []string{"at"}, codec: codecSnappy,
+					numValues: 3, totalUncompressedSize: 60, totalCompressedSize: 40, dataPageOffset: 54, dictionaryPageOffset: 40}},
+			},
+		}},
+	}
+	w := &compactWriter{}
+	writeFileMetaData(w, meta)
+	got, err := readFileMetaData(&compactReader{buf: w.buf.Bytes()})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, meta) {
+		t.Errorf("expected %+v, got %+v", meta, got)
+	}
+}
+
+func TestCompactReader_skip(t *testing.T) {
+	// a struct with unknown fields of every kind, followed by a known i32 field
+	w := &compactWriter{}
+	w.beginStruct()
+	w.boolField(1, true)
+	w.field(2, thriftDouble)
+	w.buf.Write(make([]byte, 8))
+	w.stringField(3, "skipped")
+	w.listField(4, thriftTrue, 2)
+	w.buf.Write([]byte{1, 2})
+	w.field(5, thriftMap)
+	w.varint(1)
+	w.buf.WriteByte(thriftBinary<<4 | thriftI64)
+	w.binary([]byte("k"))
+	w.zigzag(-3)
+	w.structField(6)
+	w.i64Field(1, 7)
+	w.endStruct()
+	w.i32Field(100, -42)
+	w.endStruct()
+
+	r := &compactReader{buf: w.buf.Bytes()}
+	var got int32
+	err := r.readStruct(func(id int16, typ byte) error {
+		if id == 100 {
+			var err error
+			got, err = r.i32()
+			return err
+		}
+		return r.skip(typ)
+	})
+	if err != nil || got != -42 || r.pos != len(r.buf) {
+		t.Errorf("expected -42 at the end of the struct, got %d (%v, %d/%d bytes)", got, err, r.pos, len(r.buf))
+	}
+	if err := (&compactReader{buf: w.buf.Bytes()[:10]}).skip(thriftStruct); err == nil {
+		t.Errorf("expected an error for truncated data")
+	}
+}
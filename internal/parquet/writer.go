@@ -0,0 +1,235 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Codec names accepted by Write.
+const (
+	Uncompressed = "uncompressed"
+	Snappy       = "snappy"
+	Gzip         = "gzip"
+)
+
+// DefaultRowGroupSize is the number of rows per row group used when WriteOptions.RowGroupSize is 0.
+const DefaultRowGroupSize = 1 << 20
+
+// pageSize is the approximate size of the values of a data page before compression.
+const pageSize = 1 << 20
+
+// Column is a column to write. Values must be int for "number", float64 for "float", bool
+// for "bool", string for "string" and time.Time for "date"; nil is null.
+type Column struct {
+	Name   string
+	Type   string
+	Values []any
+}
+
+// WriteOptions configures Write.
+type WriteOptions struct {
+	Codec        string // Uncompressed, Snappy or Gzip; Snappy when empty
+	RowGroupSize int    // rows per row group; DefaultRowGroupSize when 0
+}
+
+// codecs maps the codec names to their identifiers.
+var codecs = map[string]int32{Uncompressed: codecUncompressed, Snappy: codecSnappy, Gzip: codecGzip}
+
+// leafElements maps the series type tags to the schema of their column: "number" is an INT64,
+// "float" a DOUBLE, "bool" a BOOLEAN, "string" a UTF8 BYTE_ARRAY and "date" an INT64 timestamp
+// in microseconds since the Unix epoch (UTC).
+var leafElements = map[string]schemaElement{
+	"number": {physical: typeInt64, convertedType: -1},
+	"float":  {physical: typeDouble, convertedType: -1},
+	"bool":   {physical: typeBoolean, convertedType: -1},
+	"string": {physical: typeByteArray, convertedType: convertedUTF8, logical: logicalType{kind: logicalString}},
+	"date": {physical: typeInt64, convertedType: convertedTimestampMicros,
+		logical: logicalType{kind: logicalTimestamp, unit: unitMicros, adjusted: true}},
+}
+
+// countingWriter counts the bytes written, to record the offsets of the column chunks.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Write writes the columns as a Parquet file. Every column is optional (nullable) and stored
+// with the PLAIN encoding in v1 data pages.
+func Write(w io.Writer, columns []Column, opts WriteOptions) error {
+	codecName := opts.Codec
+	if codecName == "" {
+		codecName = Snappy
+	}
+	codec, ok := codecs[codecName]
+	if !ok {
+		return fmt.Errorf("parquet: unknown codec %q", opts.Codec)
+	}
+	groupSize := opts.RowGroupSize
+	if groupSize <= 0 {
+		groupSize = DefaultRowGroupSize
+	}
+
+	meta := fileMetaData{version: 1, createdBy: "go-starter"}
+	meta.schema = []schemaElement{{name: "schema", physical: -1, numChildren: int32(len(columns))}}
+	names := map[string]bool{}
+	rows := 0
+	for i, c := range columns {
+		e, ok := leafElements[c.Type]
+		if !ok {
+			return fmt.Errorf("parquet: column %q: unknown type %q", c.Name, c.Type)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("parquet: duplicated column %q", c.Name)
+		}
+		names[c.Name] = true
+		if i == 0 {
+			rows = len(c.Values)
+		} else if len(c.Values) != rows {
+			return fmt.Errorf("parquet: column %q has %d values, expected %d", c.Name, len(c.Values), rows)
+		}
+		e.name = c.Name
+		e.repetition = repetitionOptional
+		meta.schema = append(meta.schema, e)
+	}
+	meta.numRows = int64(rows)
+
+	out := &countingWriter{w: w}
+	if _, err := io.WriteString(out, magic); err != nil {
+		return err
+	}
+	for start := 0; start < rows; start += groupSize {
+		end := min(start+groupSize, rows)
+		group := rowGroup{numRows: int64(end - start)}
+		for i, c := range columns {
+			chunk, chunkMeta, err := encodeChunk(c, meta.schema[i+1], c.Values[start:end], start, codec)
+			if err != nil {
+				return err
+			}
+			chunkMeta.dataPageOffset = out.n
+			if _, err := out.Write(chunk); err != nil {
+				return err
+			}
+			group.columns = append(group.columns, columnChunk{fileOffset: chunkMeta.dataPageOffset, meta: chunkMeta})
+			group.totalByteSize += chunkMeta.totalUncompressedSize
+		}
+		meta.rowGroups = append(meta.rowGroups, group)
+	}
+
+	footer := &compactWriter{}
+	writeFileMetaData(footer, meta)
+	footer.buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(footer.buf.Len())))
+	footer.buf.WriteString(magic)
+	_, err := out.Write(footer.buf.Bytes())
+	return err
+}
+
+// encodeChunk encodes the values of a column chunk into data pages. first is the index of
+// the first value in the column, for error messages. The data page offset of the returned
+// metadata is left to the caller.
+func encodeChunk(c Column, e schemaElement, values []any, first int, codec int32) ([]byte, columnMetaData, error) {
+	meta := columnMetaData{
+		physical:  e.physical,
+		encodings: []int32{encodingPlain, encodingRLE},
+		path:      []string{c.Name},
+		codec:     codec,
+		numValues: int64(len(values)),
+	}
+	var chunk []byte
+	for start := 0; start < len(values); {
+		var defs []int32
+		var present []any
+		size := 0
+		end := start
+		for ; end < len(values) && size < pageSize; end++ {
+			if values[end] == nil {
+				defs = append(defs, 0)
+				continue
+			}
+			v, n, err := physicalValue(values[end], c.Type)
+			if err != nil {
+				return nil, meta, fmt.Errorf("parquet: column %q: row %d: %w", c.Name, first+end, err)
+			}
+			defs = append(defs, 1)
+			present = append(present, v)
+			size += n
+		}
+		levels := encodeHybrid(defs, 1)
+		body := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+		body = append(body, levels...)
+		body = encodePlain(body, present, e.physical)
+		compressed, err := compress(codec, body)
+		if err != nil {
+			return nil, meta, err
+		}
+		header := &compactWriter{}
+		writePageHeader(header, pageHeader{
+			typ:              pageData,
+			uncompressedSize: int32(len(body)),
+			compressedSize:   int32(len(compressed)),
+			data:             dataPageHeader{numValues: int32(end - start), encoding: encodingPlain, defLevelEncoding: encodingRLE},
+		})
+		meta.totalUncompressedSize += int64(header.buf.Len() + len(body))
+		chunk = append(chunk, header.buf.Bytes()...)
+		chunk = append(chunk, compressed...)
+		start = end
+	}
+	meta.totalCompressedSize = int64(len(chunk))
+	return chunk, meta, nil
+}
+
+// physicalValue converts a value to its physical representation (int64, float64, bool or
+// []byte) and returns its approximate encoded size.
+func physicalValue(v any, t string) (any, int, error) {
+	switch v := v.(type) {
+	case int:
+		if t == "number" {
+			return int64(v), 8, nil
+		}
+	case float64:
+		if t == "float" {
+			return v, 8, nil
+		}
+	case bool:
+		if t == "bool" {
+			return v, 1, nil
+		}
+	case string:
+		if t == "string" {
+			return []byte(v), 4 + len(v), nil
+		}
+	case time.Time:
+		if t == "date" {
+			return v.UnixMicro(), 8, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("cannot write %v (%T) as %s", v, v, t)
+}
+
+// compress compresses a page with the given codec.
+func compress(codec int32, data []byte) ([]byte, error) {
+	switch codec {
+	case codecSnappy:
+		return snappyEncode(data), nil
+	case codecGzip:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return data, nil
+}
@@ -0,0 +1,113 @@
+package parquet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func testColumns() []Column {
+	day := time.Date(2024, 3, 1, 12, 30, 0, 123456000, time.UTC)
+	return []Column{
+		{Name: "id", Type: "number", Values: []any{1, -2, nil, 1 << 40, 5}},
+		{Name: "score", Type: "float", Values: []any{1.5, nil, -0.25, 3.0, 0.0}},
+		{Name: "active", Type: "bool", Values: []any{true, false, nil, true, true}},
+		{Name: "name", Type: "string", Values: []any{"Ana", "", nil, "Zoë", "Bob"}},
+		{Name: "since", Type: "date", Values: []any{day, nil, day.AddDate(-60, 0, 0), day, day}},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	testCases := []struct {
+		name         string
+		opts         WriteOptions
+		rowGroupRows []int64
+	}{
+		{"snappy", WriteOptions{}, []int64{5}},
+		{"gzip", WriteOptions{Codec: Gzip}, []int64{5}},
+		{"uncompressed row groups", WriteOptions{Codec: Uncompressed, RowGroupSize: 2}, []int64{2, 2, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			columns := testColumns()
+			if err := Write(&buf, columns, tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if f.NumRows() != 5 || f.NumRowGroups() != len(tc.rowGroupRows) {
+				tt.Fatalf("expected 5 rows in %d row groups, got %d in %d", len(tc.rowGroupRows), f.NumRows(), f.NumRowGroups())
+			}
+			for i, c := range columns {
+				if field := f.Fields()[i]; field != (Field{c.Name, c.Type}) {
+					tt.Errorf("expected field %s %s, got %+v", c.Name, c.Type, field)
+				}
+				var got []any
+				for g, rows := range tc.rowGroupRows {
+					if f.RowGroupRows(g) != rows {
+						tt.Errorf("row group %d: expected %d rows, got %d", g, rows, f.RowGroupRows(g))
+					}
+					values, err := f.ReadColumn(g, i)
+					if err != nil {
+						tt.Fatalf("unexpected error %v", err)
+					}
+					got = append(got, values...)
+				}
+				if !is.Equal(got, c.Values) {
+					tt.Errorf("column %s: expected %v, got %v", c.Name, c.Values, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWrite_pages(t *testing.T) {
+	// values larger than a page are split over several data pages
+	values := make([]any, 3000)
+	for i := range values {
+		values[i] = strings.Repeat("x", 1000)
+	}
+	values[1234] = nil
+	var buf bytes.Buffer
+	if err := Write(&buf, []Column{{Name: "text", Type: "string", Values: values}}, WriteOptions{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got, err := f.ReadColumn(0, 0)
+	if err != nil || !is.Equal(got, values) {
+		t.Errorf("round trip failed: %v", err)
+	}
+}
+
+func TestWrite_errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		columns []Column
+		opts    WriteOptions
+		wantErr string
+	}{
+		{"unknown codec", nil, WriteOptions{Codec: "zstd"}, `unknown codec "zstd"`},
+		{"unknown type", []Column{{Name: "a", Type: "decimal"}}, WriteOptions{}, `column "a": unknown type "decimal"`},
+		{"duplicated column", []Column{{Name: "a", Type: "bool"}, {Name: "a", Type: "bool"}}, WriteOptions{}, `duplicated column "a"`},
+		{"lengths", []Column{{Name: "a", Type: "bool", Values: []any{true}}, {Name: "b", Type: "bool"}}, WriteOptions{}, `column "b" has 0 values, expected 1`},
+		{"value type", []Column{{Name: "a", Type: "number", Values: []any{1, "2"}}}, WriteOptions{}, `column "a": row 1: cannot write 2 (string) as number`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tc.columns, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
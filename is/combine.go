@@ -0,0 +1,97 @@
+package is
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// Not returns a predicate that negates predicate.
+// examples:
+//
+//	fn.Filter([]int{1, 2, 3}, is.Not(is.OneOf(2))) // [1, 3]
+func Not[T any](predicate func(T) bool) func(T) bool {
+	return func(value T) bool {
+		return !predicate(value)
+	}
+}
+
+// And returns a predicate that is true when all predicates are true (true when there is
+// none). It stops at the first false predicate.
+// examples:
+//
+//	positiveEven := is.And(is.Between(1, 100), func(v int) bool { return v%2 == 0 })
+//	positiveEven(4) // true
+func And[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if !predicate(value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate that is true when at least one predicate is true (false when
+// there is none). It stops at the first true predicate.
+// examples:
+//
+//	contact := is.Or(is.Email, is.URL)
+//	contact("https://example.com") // true
+func Or[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if predicate(value) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Between returns a predicate that is true for values in [lower, upper].
+// examples:
+//
+//	is.Between(1, 10)(10) // true
+//	is.Between("a", "m")("z") // false
+func Between[T cmp.Ordered](lower T, upper T) func(T) bool {
+	return func(value T) bool {
+		return cmp.Compare(value, lower) >= 0 && cmp.Compare(value, upper) <= 0
+	}
+}
+
+// OneOf returns a predicate that is true for the given values.
+// examples:
+//
+//	fn.Filter([]string{"FR", "US", "BE"}, is.OneOf("FR", "BE")) // [FR, BE]
+func OneOf[T comparable](values ...T) func(T) bool {
+	set := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return func(value T) bool {
+		_, ok := set[value]
+		return ok
+	}
+}
+
+// Empty returns true if the value is nil or is a string, slice, map, array or channel of
+// length 0. Unlike Falsy, zero numbers and false are not empty.
+// examples:
+//
+//	is.Empty("") // true
+//	is.Empty([]int{}) // true
+//	is.Empty(0) // false
+func Empty[T any](value T) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
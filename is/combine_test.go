@@ -0,0 +1,73 @@
+package is
+
+import "testing"
+
+func TestIs_Combine(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	testCases := []struct {
+		name      string
+		predicate func(int) bool
+		value     int
+		expected  bool
+	}{
+		{"not", Not(even), 3, true},
+		{"and", And(even, Between(1, 10)), 4, true},
+		{"and out of range", And(even, Between(1, 10)), 12, false},
+		{"and without predicates", And[int](), 1, true},
+		{"or", Or(even, OneOf(3, 5)), 5, true},
+		{"or none", Or(even, OneOf(3, 5)), 7, false},
+		{"or without predicates", Or[int](), 1, false},
+		{"between lower bound", Between(1, 10), 1, true},
+		{"between upper bound", Between(1, 10), 10, true},
+		{"not between", Between(1, 10), 0, false},
+		{"one of", OneOf(1, 2), 2, true},
+		{"not one of", OneOf(1, 2), 3, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := testCase.predicate(testCase.value)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIs_CombineShortCircuit(t *testing.T) {
+	calls := 0
+	counted := func(v string) bool {
+		calls++
+		return true
+	}
+	And(Between("a", "b"), counted)("z")
+	Or(OneOf("z"), counted)("z")
+	if calls != 0 {
+		t.Errorf("Expected no call after a decisive predicate, got %d", calls)
+	}
+}
+
+func TestIs_Empty(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    any
+		expected bool
+	}{
+		{"empty string", "", true},
+		{"string", "a", false},
+		{"empty slice", []int{}, true},
+		{"nil map", map[string]int(nil), true},
+		{"empty array", [0]string{}, true},
+		{"nil", nil, true},
+		{"nil pointer", (*int)(nil), true},
+		{"zero", 0, false},
+		{"false", false, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Empty(testCase.value)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -1,3 +1,4 @@
 // Package is provides predicates and helpers to inspect values and slices.
-// It includes utilities like In, Zero, Truthy/Falsy checks, and SameSlice.
+// It includes utilities like In, Zero, Truthy/Falsy checks, SameSlice, deep Equal,
+// predicate combinators (Not, And, Or, Between, OneOf) and validators (Email, URL, ...).
 package is
@@ -0,0 +1,136 @@
+package is
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// Equal checks if two values are deeply equal: slices, arrays, maps, pointers and structs
+// are compared element by element. Unlike reflect.DeepEqual, a nil slice or map equals an
+// empty one, NaN equals NaN and time.Time values are equal when they denote the same instant.
+// Values of different types are never equal.
+// examples:
+//
+//	is.Equal([][]int{{1}, {2, 3}}, [][]int{{1}, {2, 3}}) // true
+//	is.Equal(map[string][]int{"a": nil}, map[string][]int{"a": {}}) // true
+//	is.Equal(1, 1.0) // false
+func Equal(a any, b any) bool {
+	return EqualWithin(a, b, 0)
+}
+
+// EqualWithin is like Equal but floating point numbers, at any depth, are equal when
+// they differ by at most tolerance.
+// examples:
+//
+//	is.EqualWithin([]float64{x + 0.2}, []float64{0.3}, 1e-9) // true with x := 0.1
+//	is.EqualWithin(map[string]float64{"a": 1}, map[string]float64{"a": 1.5}, 0.1) // false
+func EqualWithin(a any, b any, tolerance float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return deepEqual(reflect.ValueOf(a), reflect.ValueOf(b), tolerance, map[visit]bool{})
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// visit is a pair of pointers, maps or slices being compared. Like reflect.DeepEqual,
+// deepEqual records them so that cyclic values compare in finite time: a pair met again
+// is assumed equal, the comparison in progress deciding the result.
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func deepEqual(a reflect.Value, b reflect.Value, tolerance float64, visited map[visit]bool) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		// slices sharing their first element but of different lengths are not a cycle
+		if !a.IsNil() && !b.IsNil() && (a.Kind() == reflect.Pointer || a.Len() == b.Len()) {
+			v := visit{a.Pointer(), b.Pointer(), a.Type()}
+			if visited[v] {
+				return true
+			}
+			visited[v] = true
+		}
+	}
+	if a.Type() == timeType && a.CanInterface() {
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return math.IsNaN(x) && math.IsNaN(y)
+		}
+		return x == y || math.Abs(x-y) <= tolerance
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		return deepEqual(reflect.ValueOf(real(x)), reflect.ValueOf(real(y)), tolerance, visited) &&
+			deepEqual(reflect.ValueOf(imag(x)), reflect.ValueOf(imag(y)), tolerance, visited)
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepEqual(a.Index(i), b.Index(i), tolerance, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, key := range a.MapKeys() {
+			other := b.MapIndex(key)
+			if !other.IsValid() || !deepEqual(a.MapIndex(key), other, tolerance, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() && b.IsNil()
+		}
+		if a.Kind() == reflect.Pointer && a.Pointer() == b.Pointer() {
+			return true
+		}
+		return deepEqual(a.Elem(), b.Elem(), tolerance, visited)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !deepEqual(a.Field(i), b.Field(i), tolerance, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	default:
+		return valueOf(a) == valueOf(b)
+	}
+}
+
+// valueOf returns the basic value held by v, which may come from an unexported field.
+// Channels and unsafe pointers are represented by their address.
+func valueOf(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.String:
+		return v.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return v.Pointer()
+	}
+	return nil
+}
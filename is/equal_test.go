@@ -0,0 +1,81 @@
+package is
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIs_Equal(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	instant := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	one, otherOne := 1, 1
+	tenth := 0.1
+	type node struct {
+		value int
+		next  *node
+	}
+	ring, otherRing, longerRing := &node{value: 1}, &node{value: 1}, &node{value: 1, next: &node{value: 2}}
+	ring.next, otherRing.next, longerRing.next.next = ring, otherRing, longerRing
+	cycle, otherCycle := []any{1, nil}, []any{1, nil}
+	cycle[1], otherCycle[1] = cycle, otherCycle
+	testCases := []struct {
+		name     string
+		value1   any
+		value2   any
+		expected bool
+	}{
+		{"same int", 1, 1, true},
+		{"different types", 1, 1.0, false},
+		{"nested slices", [][]int{{1}, {2, 3}}, [][]int{{1}, {2, 3}}, true},
+		{"nested slices differ", [][]int{{1}, {2, 3}}, [][]int{{1}, {3, 2}}, false},
+		{"nil and empty slice", []int(nil), []int{}, true},
+		{"maps", map[string][]any{"a": {1, "x"}}, map[string][]any{"a": {1, "x"}}, true},
+		{"maps with different keys", map[string]int{"a": 1}, map[string]int{"b": 1}, false},
+		{"nil and empty map", map[string]int(nil), map[string]int{}, true},
+		{"arrays", [2]string{"a", "b"}, [2]string{"a", "b"}, true},
+		{"pointers to equal values", &one, &otherOne, true},
+		{"struct with unexported fields", struct{ a []int }{[]int{1}}, struct{ a []int }{[]int{1}}, true},
+		{"NaN", math.NaN(), math.NaN(), true},
+		{"same instant", instant, instant.In(paris), true},
+		{"float noise", tenth + 0.2, 0.3, false},
+		{"cyclic pointers", ring, otherRing, true},
+		{"cyclic pointers differ", ring, longerRing, false},
+		{"cyclic slices", cycle, otherCycle, true},
+		{"nil", nil, nil, true},
+		{"nil and value", nil, 0, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Equal(testCase.value1, testCase.value2)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIs_EqualWithin(t *testing.T) {
+	tenth := 0.1
+	testCases := []struct {
+		name      string
+		value1    any
+		value2    any
+		tolerance float64
+		expected  bool
+	}{
+		{"float noise", tenth + 0.2, 0.3, 1e-9, true},
+		{"nested floats", map[string][]float64{"a": {1, 2.0001}}, map[string][]float64{"a": {1, 2}}, 1e-3, true},
+		{"out of tolerance", []float64{1}, []float64{1.5}, 0.1, false},
+		{"float32", []float32{1.25}, []float32{1.2}, 0.1, true},
+		{"ints are exact", []int{1}, []int{2}, 5, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := EqualWithin(testCase.value1, testCase.value2, testCase.tolerance)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
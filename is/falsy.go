@@ -1,37 +1,55 @@
 package is
 
-// Falsy returns true if the value is falsy.
-// 0, "", false, nil, []int{}, map[string]int{} are falsy values.
+import "reflect"
+
+// zeroer is implemented by types that know whether they hold their zero value, such as time.Time.
+type zeroer interface {
+	IsZero() bool
+}
+
+// Falsy returns true if the value is falsy:
+//   - nil, false, numbers equal to zero (see Zero) and "";
+//   - slices, maps and arrays of length 0 (nil ones included), whatever their element type;
+//   - nil pointers, channels, functions and interfaces;
+//   - structs without fields (struct{} and any named empty struct);
+//   - values implementing IsZero() bool that report true, e.g. the zero time.Time.
+//
+// Other values, such as non-nil pointers, channels and functions or structs with fields,
+// are truthy unless they implement IsZero() bool and report true.
 // examples:
 //
 //	is.Falsy(0) // true
 //	is.Falsy("") // true
+//	is.Falsy([]int{}) // true
+//	is.Falsy(time.Time{}) // true
+//	is.Falsy(struct{ a int }{}) // false
 func Falsy(value any) bool {
 	if value == nil {
 		return true
 	}
-
-	// Check collection types
-	if m, ok := value.(map[string]any); ok && len(m) == 0 {
-		return true
-	}
-	if arr, ok := value.([]any); ok && len(arr) == 0 {
-		return true
-	}
-	if str, ok := value.(string); ok && len(str) == 0 {
-		return true
-	}
-
-	// Check struct types
-	if _, ok := value.(struct{}); ok {
-		return true
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		if v.IsNil() {
+			return true
+		}
+	case reflect.Map, reflect.Slice:
+		if v.Len() == 0 {
+			return true
+		}
+	case reflect.Array, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Struct:
+		if v.NumField() == 0 {
+			return true
+		}
+	default:
+		return Zero(value)
 	}
-
-	// Check boolean
-	if b, ok := value.(bool); ok && !b {
-		return true
+	if z, ok := value.(zeroer); ok {
+		return z.IsZero()
 	}
-
-	// Check numeric types
-	return Zero(value)
+	return false
 }
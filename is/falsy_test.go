@@ -1,6 +1,15 @@
 package is
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
+
+type emptyStruct struct{}
+
+type zeroValue struct{ zero bool }
+
+func (z zeroValue) IsZero() bool { return z.zero }
 
 func TestIs_Falsy(t *testing.T) {
 	testCases := []struct {
@@ -24,6 +33,33 @@ func TestIs_Falsy(t *testing.T) {
 		{"zero int16", int16(0), true},
 		{"zero float32", float32(0), true},
 		{"not zero float32", 1, false},
+		{"empty int slice", []int{}, true},
+		{"nil int slice", []int(nil), true},
+		{"not empty int slice", []int{0}, false},
+		{"empty int map", map[int]string{}, true},
+		{"not empty int map", map[int]string{1: ""}, false},
+		{"empty array", [0]int{}, true},
+		{"not empty array", [2]int{}, false},
+		{"zero uint16", uint16(0), true},
+		{"zero uint32", uint32(0), true},
+		{"zero uint64", uint64(0), true},
+		{"zero uintptr", uintptr(0), true},
+		{"not zero uint64", uint64(3), false},
+		{"zero complex", complex(0, 0), true},
+		{"zero duration", time.Duration(0), true},
+		{"nil pointer", (*int)(nil), true},
+		{"pointer", new(int), false},
+		{"nil channel", (chan int)(nil), true},
+		{"channel", make(chan int), false},
+		{"nil func", (func())(nil), true},
+		{"func", func() {}, false},
+		{"nil error in slice", []error{nil}, false},
+		{"named empty struct", emptyStruct{}, true},
+		{"zero time", time.Time{}, true},
+		{"time", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"pointer to zero time", &time.Time{}, true},
+		{"zeroer", zeroValue{zero: true}, true},
+		{"not zeroer", zeroValue{zero: false}, false},
 	}
 
 	for _, testCase := range testCases {
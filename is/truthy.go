@@ -1,7 +1,7 @@
 package is
 
-// Truthy returns true if the value is truthy.
-// 1, "abc", true, map[string]int{"a": 1} are truthy values.
+// Truthy returns true if the value is truthy, i.e. not Falsy.
+// 1, "abc", true, []int{0}, map[string]int{"a": 1}, new(int) are truthy values.
 // examples:
 //
 //	is.Truthy(1) // true
@@ -1,6 +1,9 @@
 package is
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestIs_Truthy(t *testing.T) {
 	testCases := []struct {
@@ -30,6 +33,13 @@ func TestIs_Truthy(t *testing.T) {
 		{"str length greater or equal to 1", "qwe", true},
 		{"str length greater or equal to 2", "qw", true},
 		{"str length greater or equal to 3", "q", true},
+		{"empty int slice", []int{}, false},
+		{"not empty int slice", []int{1}, true},
+		{"empty map", map[int]string{}, false},
+		{"nil pointer", (*string)(nil), false},
+		{"zero uint32", uint32(0), false},
+		{"named empty struct", emptyStruct{}, false},
+		{"zero time", time.Time{}, false},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(tt *testing.T) {
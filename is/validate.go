@@ -0,0 +1,63 @@
+package is
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+var numericPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// Matches returns a predicate that is true for strings matching the regular expression.
+// examples:
+//
+//	zip := is.Matches(regexp.MustCompile(`^\d{5}$`))
+//	zip("75001") // true
+func Matches(re *regexp.Regexp) func(string) bool {
+	return re.MatchString
+}
+
+// Email returns true if the value is a bare email address such as "ana@example.com"
+// (no display name, no surrounding spaces).
+// examples:
+//
+//	is.Email("ana@example.com") // true
+//	is.Email("Ana <ana@example.com>") // false
+func Email(value string) bool {
+	addr, err := mail.ParseAddress(value)
+	return err == nil && addr.Address == value
+}
+
+// URL returns true if the value is an absolute URL with a scheme and a host.
+// examples:
+//
+//	is.URL("https://example.com/a?b=c") // true
+//	is.URL("example.com") // false
+func URL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// NumericString returns true if the value is a decimal number, with an optional sign,
+// fractional part and exponent ("12", "-1.5", ".5", "1e3"). Spaces, thousands separators,
+// hexadecimal, "NaN" and "Inf" are rejected.
+// examples:
+//
+//	is.NumericString("-1.5e3") // true
+//	is.NumericString("1 234") // false
+func NumericString(value string) bool {
+	return numericPattern.MatchString(value)
+}
+
+// DateString returns a predicate that is true for strings parsed by time.Parse with layout.
+// examples:
+//
+//	isoDate := is.DateString(time.DateOnly)
+//	isoDate("2024-02-30") // false
+func DateString(layout string) func(string) bool {
+	return func(value string) bool {
+		_, err := time.Parse(layout, value)
+		return err == nil
+	}
+}
@@ -0,0 +1,44 @@
+package is
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestIs_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		predicate func(string) bool
+		value     string
+		expected  bool
+	}{
+		{"matches", Matches(regexp.MustCompile(`^\d{5}$`)), "75001", true},
+		{"does not match", Matches(regexp.MustCompile(`^\d{5}$`)), "7500", false},
+		{"email", Email, "ana@example.com", true},
+		{"email with display name", Email, "Ana <ana@example.com>", false},
+		{"email with spaces", Email, " ana@example.com", false},
+		{"not an email", Email, "ana.example.com", false},
+		{"url", URL, "https://example.com/a?b=c", true},
+		{"url without scheme", URL, "example.com", false},
+		{"url without host", URL, "mailto:ana@example.com", false},
+		{"integer", NumericString, "-12", true},
+		{"decimal", NumericString, "1.5", true},
+		{"leading dot", NumericString, ".5", true},
+		{"exponent", NumericString, "1e3", true},
+		{"thousands separator", NumericString, "1 234", false},
+		{"nan", NumericString, "NaN", false},
+		{"empty numeric", NumericString, "", false},
+		{"date", DateString(time.DateOnly), "2024-02-29", true},
+		{"invalid date", DateString(time.DateOnly), "2024-02-30", false},
+		{"other layout", DateString("02/01/2006"), "29/02/2024", true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := testCase.predicate(testCase.value)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
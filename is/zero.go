@@ -1,35 +1,30 @@
 package is
 
-// Zero returns true if the value is zero
+import "reflect"
+
+// Zero returns true if the value is a number equal to zero.
+// Every integer, unsigned integer, float and complex kind is supported, including named
+// types such as time.Duration; any other value is not zero.
 //
 //	examples:
 //		is.Zero(0) // true
 //		is.Zero(0.0) // true
+//		is.Zero(uint16(0)) // true
 //		is.Zero("") // false
 func Zero(value any) bool {
-	switch v := value.(type) {
-	case int:
-		return v == 0
-	case int8:
-		return v == 0
-	case int16:
-		return v == 0
-	case int32:
-		return v == 0
-	case int64:
-		return v == 0
-	case uint:
-		return v == 0
-	case byte:
-		return v == 0
-	case float32:
-		return v == 0
-	case float64:
-		return v == 0
-	case complex64:
-		return v == 0
-	case complex128:
-		return v == 0
+	if value == nil {
+		return false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex() == 0
 	default:
 		return false
 	}
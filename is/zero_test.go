@@ -2,6 +2,8 @@ package is
 
 import "testing"
 
+type celsius float64
+
 func TestIs_Zero(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -11,6 +13,15 @@ func TestIs_Zero(t *testing.T) {
 		{"zero", 0, true},
 		{"zero float", 0.0, true},
 		{"not zero", 1, false},
+		{"zero uint16", uint16(0), true},
+		{"zero uint32", uint32(0), true},
+		{"zero uint64", uint64(0), true},
+		{"zero uintptr", uintptr(0), true},
+		{"not zero uint64", uint64(1), false},
+		{"zero named float", celsius(0), true},
+		{"empty string", "", false},
+		{"nil", nil, false},
+		{"false", false, false},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(tt *testing.T) {
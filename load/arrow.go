@@ -0,0 +1,59 @@
+package load
+
+import (
+	"io"
+	"os"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/internal/arrow"
+)
+
+// Formats of Arrow IPC data.
+const (
+	ArrowFile   = arrow.FormatFile
+	ArrowStream = arrow.FormatStream
+)
+
+// ArrowOptions configures Arrow and ArrowWriter.
+type ArrowOptions struct {
+	Format    string // ArrowFile or ArrowStream; ArrowFile when empty
+	BatchSize int    // rows per record batch; a single batch when 0
+}
+
+// Arrow writes a dataframe to an Arrow IPC file (see ArrowWriter for the type mapping).
+// Examples:
+//
+//	err := load.Arrow(frame, "sales.arrow", load.ArrowOptions{})
+//	err := load.Arrow(frame, "sales.arrows", load.ArrowOptions{Format: load.ArrowStream})
+func Arrow(d *df.Dataframe, path string, opts ArrowOptions) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := ArrowWriter(file, d, opts); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ArrowWriter writes a dataframe as Arrow IPC data, in the file or the stream format. Every
+// field is nullable, nulls being recorded in validity bitmaps: "number" is stored as a
+// signed 64-bit Int, "float" as a double, "bool" as a Bool, "string" as Utf8 and "date" as a
+// UTC Timestamp in microseconds. The type tag of each column is kept in the field metadata
+// under the "go-starter.type" key. Headers must be unique.
+// Examples:
+//
+//	var buf bytes.Buffer
+//	err := load.ArrowWriter(&buf, frame, load.ArrowOptions{Format: load.ArrowStream, BatchSize: 10000})
+func ArrowWriter(w io.Writer, d *df.Dataframe, opts ArrowOptions) error {
+	converted, err := canonicalColumns(d, "arrow")
+	if err != nil {
+		return err
+	}
+	columns := make([]arrow.Column, len(converted))
+	for i, c := range converted {
+		columns[i] = arrow.Column{Name: c.name, Type: c.typ, Values: c.values}
+	}
+	return arrow.Write(w, columns, arrow.WriteOptions{Format: opts.Format, BatchSize: opts.BatchSize})
+}
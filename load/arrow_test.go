@@ -0,0 +1,91 @@
+package load
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/extract"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func TestArrowWriter(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts ArrowOptions
+	}{
+		{"file", ArrowOptions{}},
+		{"stream", ArrowOptions{Format: ArrowStream}},
+		{"stream with batches", ArrowOptions{Format: ArrowStream, BatchSize: 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			if err := ArrowWriter(&buf, parquetFixture(), tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			got, err := extract.ArrowReader(&buf)
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, parquetFixture(), df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestArrow(t *testing.T) {
+	// values are converted to the representation of their type tag before being written
+	frame := df.New([]series.Series[any]{
+		series.New([]any{int64(7), uint8(2), 3.0}, "number"),
+		series.New([]any{1, float32(0.5), int8(-1)}, "float"),
+	}, []string{"n", "f"})
+	expected := df.New([]series.Series[any]{
+		series.New([]any{7, 2, 3}, "number"),
+		series.New([]any{1.0, 0.5, -1.0}, "float"),
+	}, []string{"n", "f"})
+	path := filepath.Join(t.TempDir(), "out.arrow")
+	if err := Arrow(frame, path, ArrowOptions{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got, err := extract.Arrow(path)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if report := df.Diff(got, expected, df.EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+}
+
+func TestArrowWriter_errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		frame   *df.Dataframe
+		opts    ArrowOptions
+		wantErr string
+	}{
+		{
+			name:    "value of the wrong type",
+			frame:   df.New([]series.Series[any]{series.New([]any{true}, "float")}, []string{"f"}),
+			wantErr: `arrow: column "f": row 0: cannot use true (bool) as float`,
+		},
+		{
+			name:    "unknown format",
+			frame:   parquetFixture(),
+			opts:    ArrowOptions{Format: "feather"},
+			wantErr: `unknown format "feather"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			err := ArrowWriter(&buf, tc.frame, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
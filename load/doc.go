@@ -0,0 +1,5 @@
+// Package load writes dataframes to files and databases.
+// It is the counterpart of the extract package: Parquet files and Arrow IPC data are written
+// with the same type mapping as extract.Parquet and extract.Arrow read them, and dataframes
+// are inserted into SQL tables.
+package load
@@ -0,0 +1,57 @@
+package load
+
+import (
+	"io"
+	"os"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/internal/parquet"
+)
+
+// Compression codecs of Parquet files.
+const (
+	CodecUncompressed = parquet.Uncompressed
+	CodecSnappy       = parquet.Snappy
+	CodecGzip         = parquet.Gzip
+)
+
+// ParquetOptions configures Parquet and ParquetWriter.
+type ParquetOptions struct {
+	Codec        string // CodecUncompressed, CodecSnappy or CodecGzip; CodecSnappy when empty
+	RowGroupSize int    // rows per row group; 1 048 576 when 0
+}
+
+// Parquet writes a dataframe to a Parquet file (see ParquetWriter for the type mapping).
+// Examples:
+//
+//	err := load.Parquet(frame, "sales.parquet", load.ParquetOptions{Codec: load.CodecGzip})
+func Parquet(d *df.Dataframe, path string, opts ParquetOptions) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := ParquetWriter(file, d, opts); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ParquetWriter writes a dataframe as Parquet data. Every column is nullable: "number" is
+// stored as INT64, "float" as DOUBLE, "bool" as BOOLEAN, "string" as a UTF8 BYTE_ARRAY and
+// "date" as a TIMESTAMP in microseconds (UTC). Headers must be unique.
+// Examples:
+//
+//	var buf bytes.Buffer
+//	err := load.ParquetWriter(&buf, frame, load.ParquetOptions{RowGroupSize: 10000})
+func ParquetWriter(w io.Writer, d *df.Dataframe, opts ParquetOptions) error {
+	converted, err := canonicalColumns(d, "parquet")
+	if err != nil {
+		return err
+	}
+	columns := make([]parquet.Column, len(converted))
+	for i, c := range converted {
+		columns[i] = parquet.Column{Name: c.name, Type: c.typ, Values: c.values}
+	}
+	return parquet.Write(w, columns, parquet.WriteOptions{Codec: opts.Codec, RowGroupSize: opts.RowGroupSize})
+}
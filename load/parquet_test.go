@@ -0,0 +1,127 @@
+package load
+
+import (
+	"bytes"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/extract"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+func parquetFixture() *df.Dataframe {
+	day := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	return df.New([]series.Series[any]{
+		series.NewNullable([]any{"Ana", nil, "Zoë"}, "string"),
+		series.NewNullable([]any{31, 45, nil}, "number"),
+		series.New([]any{1.5, -2.25, 0.0}, "float"),
+		series.NewNullable([]any{true, nil, false}, "bool"),
+		series.NewNullable([]any{day, day.AddDate(0, 1, 0), nil}, "date"),
+	}, []string{"name", "age", "score", "active", "since"})
+}
+
+func TestParquetWriter(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts ParquetOptions
+	}{
+		{"default codec", ParquetOptions{}},
+		{"uncompressed", ParquetOptions{Codec: CodecUncompressed}},
+		{"gzip with row groups", ParquetOptions{Codec: CodecGzip, RowGroupSize: 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			if err := ParquetWriter(&buf, parquetFixture(), tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			got, err := extract.ParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if report := df.Diff(got, parquetFixture(), df.EqualOptions{}); report != "" {
+				tt.Errorf("dataframes differ:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestParquetWriter_conversions(t *testing.T) {
+	// values are converted to the representation of their type tag before being written
+	frame := df.New([]series.Series[any]{
+		series.New([]any{int64(7), uint8(2), 3.0}, "number"),
+		series.New([]any{1, float32(0.5), int8(-1)}, "float"),
+		series.New([]any{"2024-03-01", "2024-03-02T10:00:00Z", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)}, "date"),
+	}, []string{"n", "f", "d"})
+	expected := df.New([]series.Series[any]{
+		series.New([]any{7, 2, 3}, "number"),
+		series.New([]any{1.0, 0.5, -1.0}, "float"),
+		series.New([]any{
+			time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC),
+		}, "date"),
+	}, []string{"n", "f", "d"})
+	path := filepath.Join(t.TempDir(), "out.parquet")
+	if err := Parquet(frame, path, ParquetOptions{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got, err := extract.Parquet(path)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if report := df.Diff(got, expected, df.EqualOptions{}); report != "" {
+		t.Errorf("dataframes differ:\n%s", report)
+	}
+}
+
+func TestParquetWriter_errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		frame   *df.Dataframe
+		opts    ParquetOptions
+		wantErr string
+	}{
+		{
+			name:    "value of the wrong type",
+			frame:   df.New([]series.Series[any]{series.New([]any{1, 2.5}, "number")}, []string{"n"}),
+			wantErr: `column "n": row 1: cannot use 2.5 (float64) as number`,
+		},
+		{
+			name:    "number out of range",
+			frame:   df.New([]series.Series[any]{series.NewNullable([]any{uint64(math.MaxUint64)}, "number")}, []string{"n"}),
+			wantErr: `column "n": row 0: cannot use 18446744073709551615 (uint64) as number`,
+		},
+		{
+			name:    "invalid date",
+			frame:   df.New([]series.Series[any]{series.New([]any{"soon"}, "date")}, []string{"d"}),
+			wantErr: `column "d": row 0: cannot parse "soon" as a date`,
+		},
+		{
+			name: "duplicated header",
+			frame: df.New([]series.Series[any]{
+				series.New([]any{1}, "number"), series.New([]any{2}, "number"),
+			}, []string{"n", "n"}),
+			wantErr: `duplicated column "n"`,
+		},
+		{
+			name:    "unknown codec",
+			frame:   parquetFixture(),
+			opts:    ParquetOptions{Codec: "lz4"},
+			wantErr: `unknown codec "lz4"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			err := ParquetWriter(&buf, tc.frame, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
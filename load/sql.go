@@ -0,0 +1,129 @@
+package load
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/visual-pivert/go-starter/df"
+)
+
+// Placeholder styles of SQL drivers.
+const (
+	PlaceholderQuestion = "?"  // ?, ?, ? (MySQL, SQLite)
+	PlaceholderDollar   = "$"  // $1, $2, $3 (PostgreSQL)
+	PlaceholderColon    = ":"  // :1, :2, :3 (Oracle)
+	PlaceholderAt       = "@p" // @p1, @p2, @p3 (SQL Server)
+)
+
+// DefaultSqlBatchSize is the number of rows per INSERT statement used when
+// SqlOptions.BatchSize is 0.
+const DefaultSqlBatchSize = 500
+
+// DefaultSqlMaxParams is the number of parameters per INSERT statement used when
+// SqlOptions.MaxParams is 0: the limit of SQLite before 3.32, the lowest of the common
+// databases (SQL Server accepts 2100, PostgreSQL 65535).
+const DefaultSqlMaxParams = 999
+
+// SqlOptions configures Sql.
+type SqlOptions struct {
+	BatchSize   int    // rows per INSERT statement; DefaultSqlBatchSize when 0
+	MaxParams   int    // parameters per INSERT statement, lowering BatchSize for wide dataframes; DefaultSqlMaxParams when 0
+	Placeholder string // PlaceholderQuestion, PlaceholderDollar, PlaceholderColon or PlaceholderAt; PlaceholderQuestion when empty
+	Quote       string // identifier quote of the column names; " when empty (use ` for MySQL)
+}
+
+// Sql inserts the rows of a dataframe into an existing table, in a single transaction, with
+// multi-row INSERT statements of at most BatchSize rows and MaxParams parameters. Headers
+// are the column names; the table name is written as given so that it can hold a schema
+// (public.sales). Values are passed as int64, float64, bool, string and time.Time
+// arguments, nulls as NULL.
+// Examples:
+//
+//	err := load.Sql(db, frame, "rates", load.SqlOptions{Placeholder: load.PlaceholderDollar})
+//	// INSERT INTO rates ("currency", "rate") VALUES ($1, $2), ($3, $4), ...
+func Sql(db *sql.DB, d *df.Dataframe, table string, opts SqlOptions) error {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultSqlBatchSize
+	}
+	placeholder := opts.Placeholder
+	if placeholder == "" {
+		placeholder = PlaceholderQuestion
+	}
+	if placeholder != PlaceholderQuestion && placeholder != PlaceholderDollar &&
+		placeholder != PlaceholderColon && placeholder != PlaceholderAt {
+		return fmt.Errorf("sql: unknown placeholder style %q", opts.Placeholder)
+	}
+	quote := opts.Quote
+	if quote == "" {
+		quote = `"`
+	}
+	columns, err := canonicalColumns(d, "sql")
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return fmt.Errorf("sql: no column to insert into %s", table)
+	}
+	maxParams := opts.MaxParams
+	if maxParams <= 0 {
+		maxParams = DefaultSqlMaxParams
+	}
+	if len(columns) > maxParams {
+		return fmt.Errorf("sql: %d columns exceed the limit of %d parameters per statement", len(columns), maxParams)
+	}
+	batchSize = min(batchSize, maxParams/len(columns))
+
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = quote + strings.ReplaceAll(c.name, quote, quote+quote) + quote
+	}
+	prefix := "INSERT INTO " + table + " (" + strings.Join(names, ", ") + ") VALUES "
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("sql: %w", err)
+	}
+	rows := len(columns[0].values)
+	for start := 0; start < rows; start += batchSize {
+		end := min(start+batchSize, rows)
+		var query strings.Builder
+		query.WriteString(prefix)
+		args := make([]any, 0, (end-start)*len(columns))
+		for row := start; row < end; row++ {
+			if row > start {
+				query.WriteString(", ")
+			}
+			query.WriteByte('(')
+			for i, c := range columns {
+				if i > 0 {
+					query.WriteString(", ")
+				}
+				args = append(args, sqlArg(c.values[row]))
+				query.WriteString(placeholder)
+				if placeholder != PlaceholderQuestion {
+					query.WriteString(strconv.Itoa(len(args)))
+				}
+			}
+			query.WriteByte(')')
+		}
+		if _, err := tx.Exec(query.String(), args...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("sql: rows %d to %d: %w", start, end-1, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sql: %w", err)
+	}
+	return nil
+}
+
+// sqlArg converts a canonical value to a value accepted by every driver.
+func sqlArg(v any) any {
+	if n, ok := v.(int); ok {
+		return int64(n)
+	}
+	return v
+}
@@ -0,0 +1,160 @@
+package load
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/is"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// recorder is an in-process driver recording the statements it executes. The statement
+// of index failAt fails.
+type recorder struct {
+	queries  []string
+	args     [][]driver.Value
+	failAt   int
+	commits  int
+	rollback int
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return recorderConn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+type recorderConn struct{ r *recorder }
+
+func (c recorderConn) Prepare(query string) (driver.Stmt, error) {
+	return recorderStmt{c.r, query}, nil
+}
+func (c recorderConn) Close() error              { return nil }
+func (c recorderConn) Begin() (driver.Tx, error) { return recorderTx{c.r}, nil }
+
+type recorderTx struct{ r *recorder }
+
+func (tx recorderTx) Commit() error   { tx.r.commits++; return nil }
+func (tx recorderTx) Rollback() error { tx.r.rollback++; return nil }
+
+type recorderStmt struct {
+	r     *recorder
+	query string
+}
+
+func (s recorderStmt) Close() error  { return nil }
+func (s recorderStmt) NumInput() int { return -1 }
+func (s recorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if len(s.r.queries) == s.r.failAt {
+		return nil, errors.New("constraint failed")
+	}
+	s.r.queries = append(s.r.queries, s.query)
+	s.r.args = append(s.r.args, args)
+	return driver.RowsAffected(len(args)), nil
+}
+func (s recorderStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("write only")
+}
+
+func TestSql(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	frame := df.New([]series.Series[any]{
+		series.NewNullable([]any{"EUR", nil, "JPY"}, "string"),
+		series.NewNullable([]any{int32(1), 2, nil}, "number"),
+		series.New([]any{"2024-03-01", day, day}, "date"),
+	}, []string{"code", `"rank"`, "since"})
+
+	testCases := []struct {
+		name     string
+		opts     SqlOptions
+		expected []string
+		args     [][]driver.Value
+	}{
+		{
+			name:     "defaults",
+			opts:     SqlOptions{},
+			expected: []string{`INSERT INTO rates ("code", """rank""", "since") VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)`},
+			args:     [][]driver.Value{{"EUR", int64(1), day, nil, int64(2), day, "JPY", nil, day}},
+		},
+		{
+			name: "dollar placeholders in batches",
+			opts: SqlOptions{BatchSize: 2, Placeholder: PlaceholderDollar},
+			expected: []string{
+				`INSERT INTO rates ("code", """rank""", "since") VALUES ($1, $2, $3), ($4, $5, $6)`,
+				`INSERT INTO rates ("code", """rank""", "since") VALUES ($1, $2, $3)`,
+			},
+			args: [][]driver.Value{{"EUR", int64(1), day, nil, int64(2), day}, {"JPY", nil, day}},
+		},
+		{
+			name: "batches limited by the parameters",
+			opts: SqlOptions{MaxParams: 7},
+			expected: []string{
+				`INSERT INTO rates ("code", """rank""", "since") VALUES (?, ?, ?), (?, ?, ?)`,
+				`INSERT INTO rates ("code", """rank""", "since") VALUES (?, ?, ?)`,
+			},
+			args: [][]driver.Value{{"EUR", int64(1), day, nil, int64(2), day}, {"JPY", nil, day}},
+		},
+		{
+			name:     "SQL Server placeholders and MySQL quotes",
+			opts:     SqlOptions{BatchSize: 3, Placeholder: PlaceholderAt, Quote: "`"},
+			expected: []string{"INSERT INTO rates (`code`, `\"rank\"`, `since`) VALUES (@p1, @p2, @p3), (@p4, @p5, @p6), (@p7, @p8, @p9)"},
+			args:     [][]driver.Value{{"EUR", int64(1), day, nil, int64(2), day, "JPY", nil, day}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			r := &recorder{failAt: -1}
+			db := sql.OpenDB(r)
+			defer db.Close()
+			if err := Sql(db, frame, "rates", tc.opts); err != nil {
+				tt.Fatalf("unexpected error %v", err)
+			}
+			if !is.SameSlice(r.queries, tc.expected) {
+				tt.Errorf("expected queries %q, got %q", tc.expected, r.queries)
+			}
+			if !is.Equal(r.args, tc.args) {
+				tt.Errorf("expected arguments %v, got %v", tc.args, r.args)
+			}
+			if r.commits != 1 || r.rollback != 0 {
+				tt.Errorf("expected a single commit, got %d commits and %d rollbacks", r.commits, r.rollback)
+			}
+		})
+	}
+}
+
+func TestSql_errors(t *testing.T) {
+	frame := df.New([]series.Series[any]{series.New([]any{1, 2, 3}, "number")}, []string{"n"})
+	testCases := []struct {
+		name     string
+		frame    *df.Dataframe
+		opts     SqlOptions
+		failAt   int
+		wantErr  string
+		rollback int
+	}{
+		{"placeholder", frame, SqlOptions{Placeholder: "%s"}, -1, `unknown placeholder style "%s"`, 0},
+		{"no column", df.New(nil, nil), SqlOptions{}, -1, "no column to insert into t", 0},
+		{"parameters", df.New([]series.Series[any]{series.New([]any{1}, "number"), series.New([]any{2}, "number")}, []string{"a", "b"}),
+			SqlOptions{MaxParams: 1}, -1, "sql: 2 columns exceed the limit of 1 parameters per statement", 0},
+		{"value", df.New([]series.Series[any]{series.New([]any{true}, "float")}, []string{"f"}), SqlOptions{}, -1,
+			`sql: column "f": row 0: cannot use true (bool) as float`, 0},
+		{"statement", frame, SqlOptions{BatchSize: 2}, 1, "sql: rows 2 to 2: constraint failed", 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			r := &recorder{failAt: tc.failAt}
+			db := sql.OpenDB(r)
+			defer db.Close()
+			err := Sql(db, tc.frame, "t", tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				tt.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+			if r.commits != 0 || r.rollback != tc.rollback {
+				tt.Errorf("expected %d rollbacks and no commit, got %d and %d", tc.rollback, r.rollback, r.commits)
+			}
+		})
+	}
+}
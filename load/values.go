@@ -0,0 +1,69 @@
+package load
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/visual-pivert/go-starter/df"
+	"github.com/visual-pivert/go-starter/series"
+)
+
+// column is a column of a dataframe holding the canonical Go values of its type tag.
+type column struct {
+	name   string
+	typ    string
+	values []any
+}
+
+// canonicalColumns converts the columns of a dataframe to the canonical Go values of their
+// type tags (int, float64, bool, string and time.Time), keeping nulls as nil. Errors are
+// prefixed with the name of the format.
+func canonicalColumns(d *df.Dataframe, format string) ([]column, error) {
+	headers := d.GetHeaders()
+	columns := make([]column, len(headers))
+	for i, header := range headers {
+		s, _ := d.GetSeries(i)
+		nulls := s.IsNull().ToSlice()
+		values := make([]any, s.Len())
+		for row, v := range s.ToSlice() {
+			if nulls[row] {
+				continue
+			}
+			converted, err := canonicalValue(v, s.Type())
+			if err != nil {
+				return nil, fmt.Errorf("%s: column %q: row %d: %w", format, header, row, err)
+			}
+			values[row] = converted
+		}
+		columns[i] = column{name: header, typ: s.Type(), values: values}
+	}
+	return columns, nil
+}
+
+// canonicalValue converts a non-null value to the canonical Go value of the type tag t.
+func canonicalValue(v any, t string) (any, error) {
+	switch t {
+	case "number":
+		if i, ok := series.ToInt(v); ok {
+			return i, nil
+		}
+	case "float":
+		if f, ok := series.ToFloat(v); ok {
+			return f, nil
+		}
+	case "bool":
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case "date":
+		return series.ParseDate(v)
+	case "string":
+		if tm, ok := v.(time.Time); ok {
+			return tm.Format(time.RFC3339Nano), nil
+		}
+		return fmt.Sprint(v), nil
+	default:
+		return nil, fmt.Errorf("unknown type %q", t)
+	}
+	return nil, fmt.Errorf("cannot use %v (%T) as %s", v, v, t)
+}
@@ -0,0 +1,142 @@
+package series
+
+import (
+	"fmt"
+	"math"
+)
+
+// Add returns the element-wise sum of the Series and other.
+// other is either a Series (of any element type) with the same length, or a scalar
+// applied to every element. Both sides must be "number" or "float": the result is a
+// "number" Series when both are numbers and a "float" Series otherwise. A null on
+// either side gives a null. It panics on non-numeric types or mismatched lengths.
+// Examples:
+//
+//	price := series.New([]float64{1.5, 2}, "float")
+//	qty := series.New([]int{2, 3}, "number")
+//	price.Add(qty) // return Series of type float with values [3.5, 5]
+//	qty.Add(1)     // return Series of type number with values [3, 4]
+func (s Series[T]) Add(other any) Series[any] {
+	return s.arithmetic("+", other)
+}
+
+// Sub returns the element-wise difference of the Series and other (see Add).
+// Examples:
+//
+//	s := series.New([]int{5, 7}, "number")
+//	s.Sub(2) // return Series of type number with values [3, 5]
+func (s Series[T]) Sub(other any) Series[any] {
+	return s.arithmetic("-", other)
+}
+
+// Mul returns the element-wise product of the Series and other (see Add).
+// Examples:
+//
+//	s := series.New([]int{5, 7}, "number")
+//	s.Mul(2.5) // return Series of type float with values [12.5, 17.5]
+func (s Series[T]) Mul(other any) Series[any] {
+	return s.arithmetic("*", other)
+}
+
+// Div returns the element-wise quotient of the Series and other (see Add).
+// The result is always a "float" Series; division by zero follows IEEE 754 (±Inf, NaN).
+// Examples:
+//
+//	s := series.New([]int{5, 7}, "number")
+//	s.Div(2) // return Series of type float with values [2.5, 3.5]
+func (s Series[T]) Div(other any) Series[any] {
+	return s.arithmetic("/", other)
+}
+
+// Mod returns the element-wise remainder of the Series and other (see Add).
+// An integer modulo by zero gives a null.
+// Examples:
+//
+//	s := series.New([]int{5, 7}, "number")
+//	s.Mod(4) // return Series of type number with values [1, 3]
+func (s Series[T]) Mod(other any) Series[any] {
+	return s.arithmetic("%", other)
+}
+
+// Pow returns the Series raised element-wise to the power other (see Add).
+// The result is always a "float" Series.
+// Examples:
+//
+//	s := series.New([]int{2, 3}, "number")
+//	s.Pow(2) // return Series of type float with values [4, 9]
+func (s Series[T]) Pow(other any) Series[any] {
+	return s.arithmetic("^", other)
+}
+
+// arithmetic applies op element-wise between the Series and other.
+func (s Series[T]) arithmetic(op string, other any) Series[any] {
+	get, otherType := broadcast(other, len(s.data))
+	if !IsNumericType(s.t) || !(IsNumericType(otherType) || otherType == "null") {
+		panic(fmt.Sprintf("operator %s not defined on %s and %s", op, s.t, otherType))
+	}
+	t := "float"
+	if s.t == "number" && otherType != "float" && op != "/" && op != "^" {
+		t = "number"
+	}
+	out := make([]any, len(s.data))
+	for i, value := range s.data {
+		a, b := any(value), get(i)
+		if a == nil || b == nil {
+			continue
+		}
+		out[i] = Arithmetic(op, a, b, t)
+	}
+	return Series[any]{out, t}
+}
+
+// Arithmetic computes op ("+", "-", "*", "/", "%" or "^") between two non-null numeric
+// values as type t ("number" or "float"). "+", "-", "*" and "%" stay integers for
+// "number", and an integer modulo by zero gives nil; "/" and "^" always give a float64
+// and follow IEEE 754 (±Inf, NaN). It returns nil when a value is not numeric.
+// Examples:
+//
+//	series.Arithmetic("+", 2, 3, "number")  // 5
+//	series.Arithmetic("/", 7, 2, "number")  // 3.5
+//	series.Arithmetic("%", 7, 0, "number")  // <nil>
+//	series.Arithmetic("*", 1.5, 2, "float") // 3.0
+func Arithmetic(op string, a any, b any, t string) any {
+	if t == "number" && op != "/" && op != "^" {
+		x, okA := ToInt(a)
+		y, okB := ToInt(b)
+		if !okA || !okB {
+			return nil
+		}
+		switch op {
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		default:
+			if y == 0 {
+				return nil
+			}
+			return x % y
+		}
+	}
+	x, okA := ToFloat(a)
+	y, okB := ToFloat(b)
+	if !okA || !okB {
+		return nil
+	}
+	switch op {
+	case "+":
+		return x + y
+	case "-":
+		return x - y
+	case "*":
+		return x * y
+	case "/":
+		return x / y
+	case "%":
+		return math.Mod(x, y)
+	default:
+		return math.Pow(x, y)
+	}
+}
@@ -0,0 +1,83 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Arithmetic(t *testing.T) {
+	numbers := NewNullable([]any{4, nil, 9}, "number")
+	floats := New([]float64{0.5, 1, 2}, "float")
+	testCases := []struct {
+		name         string
+		got          Series[any]
+		expectedType string
+		expected     []any
+	}{
+		{"add series", numbers.Add(New([]int{1, 2, 3}, "number")), "number", []any{5, nil, 12}},
+		{"add promotes to float", numbers.Add(floats), "float", []any{4.5, nil, 11.0}},
+		{"sub scalar", numbers.Sub(1), "number", []any{3, nil, 8}},
+		{"mul float scalar", numbers.Mul(0.5), "float", []any{2.0, nil, 4.5}},
+		{"div is float", numbers.Div(2), "float", []any{2.0, nil, 4.5}},
+		{"mod", numbers.Mod(4), "number", []any{0, nil, 1}},
+		{"mod by zero", numbers.Mod(0), "number", []any{nil, nil, nil}},
+		{"pow", floats.Pow(2), "float", []any{0.25, 1.0, 4.0}},
+		{"null scalar", floats.Add(nil), "float", []any{nil, nil, nil}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if testCase.got.Type() != testCase.expectedType {
+				tt.Errorf("Expected type %v, got %v", testCase.expectedType, testCase.got.Type())
+			}
+			if !is.SameSlice(testCase.got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_ArithmeticPanics(t *testing.T) {
+	testCases := []struct {
+		name string
+		fn   func()
+	}{
+		{"string operand", func() { New([]int{1}, "number").Add(New([]string{"a"}, "string")) }},
+		{"length mismatch", func() { New([]int{1}, "number").Add(New([]int{1, 2}, "number")) }},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			defer func() {
+				if recover() == nil {
+					tt.Errorf("Expected a panic")
+				}
+			}()
+			testCase.fn()
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		op       string
+		a, b     any
+		t        string
+		expected any
+	}{
+		{"integer sum", "+", 2, int64(3), "number", 5},
+		{"integer division is float", "/", 7, 2, "number", 3.5},
+		{"integer power is float", "^", 2, 3, "number", 8.0},
+		{"integer modulo by zero", "%", 7, 0, "number", nil},
+		{"float product", "*", 1.5, 2, "float", 3.0},
+		{"not numeric", "+", "1", 2, "number", nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := Arithmetic(testCase.op, testCase.a, testCase.b, testCase.t)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v (%T), got %v (%T)", testCase.expected, testCase.expected, got, got)
+			}
+		})
+	}
+}
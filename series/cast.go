@@ -0,0 +1,210 @@
+package series
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// NumberFormat describes how numbers are written in strings.
+// The zero value means "." as decimal separator and no thousands separator.
+type NumberFormat struct {
+	Decimal   string // decimal separator, "." when empty
+	Thousands string // thousands separator, none when empty; " " also matches non-breaking spaces
+}
+
+// Common number formats.
+var (
+	NumberFormatEN = NumberFormat{Decimal: ".", Thousands: ","} // 1,234.56
+	NumberFormatFR = NumberFormat{Decimal: ",", Thousands: " "} // 1 234,56
+	NumberFormatDE = NumberFormat{Decimal: ",", Thousands: "."} // 1.234,56
+)
+
+// CastOptions configures Series.Cast.
+type CastOptions struct {
+	// Strict makes Cast fail with a *CastError listing every value that cannot be converted.
+	// Otherwise (lenient mode) such values become null.
+	Strict bool
+	// Number is the format used to parse numbers from strings and to format them as strings.
+	Number NumberFormat
+	// DateLayout, when set, is used to parse and format dates as strings.
+	// Otherwise dates are parsed with ParseDate and formatted as RFC 3339.
+	DateLayout string
+}
+
+// CastError is returned by Cast in strict mode when some values cannot be converted.
+type CastError struct {
+	From    string // type tag of the source Series
+	To      string // requested type tag
+	Indices []int  // indices of the values that failed to convert
+}
+
+func (e *CastError) Error() string {
+	return fmt.Sprintf("cannot cast %d value(s) from %s to %s at indices %v", len(e.Indices), e.From, e.To, e.Indices)
+}
+
+// Cast converts the Series to another type tag ("string", "number", "float", "bool" or "date").
+// Values are converted to int for "number", float64 for "float", bool for "bool", string
+// for "string" and time.Time for "date". Nulls and empty strings become nulls.
+// In lenient mode values that cannot be converted become nulls; in strict mode Cast
+// returns a *CastError with their indices.
+// Examples:
+//
+//	s := series.New([]string{"1 234,56", "12", "n/a"}, "string")
+//	out, _ := s.Cast("float", series.CastOptions{Number: series.NumberFormatFR})
+//	out.Debug() // [1234.56, 12, <nil>]
+//	_, err := s.Cast("float", series.CastOptions{Strict: true, Number: series.NumberFormatFR})
+//	// err: cannot cast 1 value(s) from string to float at indices [2]
+func (s Series[T]) Cast(t string, opts CastOptions) (Series[any], error) {
+	if is.In(t, typePossibilities) == false {
+		return Series[any]{}, fmt.Errorf("type %q not supported", t)
+	}
+	out := make([]any, len(s.data))
+	var failed []int
+	for i, value := range s.data {
+		v, ok := castValue(value, t, opts)
+		if !ok {
+			failed = append(failed, i)
+			continue
+		}
+		out[i] = v
+	}
+	if opts.Strict && len(failed) > 0 {
+		return Series[any]{}, &CastError{s.t, t, failed}
+	}
+	return Series[any]{out, t}, nil
+}
+
+// castValue converts a single value to the type tag t. Nulls and empty strings give (nil, true).
+func castValue(value any, t string, opts CastOptions) (any, bool) {
+	if value == nil {
+		return nil, true
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+		str := strings.TrimSpace(rv.String())
+		if str == "" {
+			return nil, true
+		}
+		return castString(str, t, opts)
+	}
+	switch t {
+	case "string":
+		return formatValue(value, opts), true
+	case "number":
+		if b, ok := value.(bool); ok {
+			return boolToInt(b), true
+		}
+		return ToInt(value)
+	case "float":
+		if b, ok := value.(bool); ok {
+			return float64(boolToInt(b)), true
+		}
+		return ToFloat(value)
+	case "bool":
+		if b, ok := value.(bool); ok {
+			return b, true
+		}
+		f, ok := ToFloat(value)
+		return f != 0, ok
+	default:
+		tm, err := ParseDate(value)
+		return tm, err == nil
+	}
+}
+
+// castString parses a non-empty string into the type tag t.
+func castString(s string, t string, opts CastOptions) (any, bool) {
+	switch t {
+	case "string":
+		return s, true
+	case "number":
+		normalized, ok := normalizeNumber(s, opts.Number)
+		if !ok {
+			return nil, false
+		}
+		if i, err := strconv.ParseInt(normalized, 10, 64); err == nil {
+			return int(i), true
+		}
+		// integral floats ("12.0", "1e3"); toInt rejects non-finite and out of range values
+		f, err := strconv.ParseFloat(normalized, 64)
+		if err != nil {
+			return nil, false
+		}
+		return ToInt(f)
+	case "float":
+		return parseNumber(s, opts.Number)
+	case "bool":
+		b, err := strconv.ParseBool(s)
+		return b, err == nil
+	default:
+		if opts.DateLayout != "" {
+			tm, err := time.Parse(opts.DateLayout, s)
+			return tm, err == nil
+		}
+		tm, err := ParseDate(s)
+		return tm, err == nil
+	}
+}
+
+// parseNumber parses a number written with the given format.
+func parseNumber(s string, format NumberFormat) (float64, bool) {
+	s, ok := normalizeNumber(s, format)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
+
+// normalizeNumber removes the thousands separators of a number written with the given
+// format and replaces its decimal separator with ".".
+func normalizeNumber(s string, format NumberFormat) (string, bool) {
+	if format.Thousands != "" {
+		s = strings.ReplaceAll(s, format.Thousands, "")
+		if format.Thousands == " " {
+			s = strings.NewReplacer("\u00a0", "", "\u202f", "").Replace(s)
+		}
+	}
+	if format.Decimal != "" && format.Decimal != "." {
+		if strings.Contains(s, ".") {
+			return "", false
+		}
+		s = strings.ReplaceAll(s, format.Decimal, ".")
+	}
+	return s, true
+}
+
+// formatValue formats a non-null value as a string, using the number format and date
+// layout of opts.
+func formatValue(value any, opts CastOptions) string {
+	if tm, ok := value.(time.Time); ok {
+		if opts.DateLayout != "" {
+			return tm.Format(opts.DateLayout)
+		}
+		return tm.Format(time.RFC3339)
+	}
+	if _, ok := value.(bool); ok {
+		return fmt.Sprint(value)
+	}
+	if scalarType(value) == "float" {
+		f, _ := ToFloat(value)
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if opts.Number.Decimal != "" {
+			s = strings.Replace(s, ".", opts.Number.Decimal, 1)
+		}
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// boolToInt converts true to 1 and false to 0.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
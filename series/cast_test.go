@@ -0,0 +1,68 @@
+package series
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Cast(t *testing.T) {
+	testCases := []struct {
+		name     string
+		s        Series[any]
+		t        string
+		opts     CastOptions
+		expected []any
+	}{
+		{"french floats", New([]any{"1 234,56", "1 000", "12", "n/a", ""}, "string"), "float", CastOptions{Number: NumberFormatFR}, []any{1234.56, 1000.0, 12.0, nil, nil}},
+		{"english numbers", New([]any{"1,234", "12.0", "12.5"}, "string"), "number", CastOptions{Number: NumberFormatEN}, []any{1234, 12, nil}},
+		{"float to number", New([]any{1.0, 2.5}, "float"), "number", CastOptions{}, []any{1, nil}},
+		{"exact integers", New([]any{"9007199254740993", "-9223372036854775808", "1e3"}, "string"), "number", CastOptions{}, []any{9007199254740993, math.MinInt64, 1000}},
+		{"out of range numbers", New([]any{"Inf", "NaN", "1e30", "9223372036854775808"}, "string"), "number", CastOptions{}, []any{nil, nil, nil, nil}},
+		{"out of range values", NewNullable([]any{math.Inf(1), math.NaN(), 1e30, uint64(math.MaxUint64)}, "float"), "number", CastOptions{}, []any{nil, nil, nil, nil}},
+		{"number to string", New([]any{1, 2.5}, "float"), "string", CastOptions{Number: NumberFormatFR}, []any{"1", "2,5"}},
+		{"to bool", New([]any{"true", "0", "maybe"}, "string"), "bool", CastOptions{}, []any{true, false, nil}},
+		{"number to bool", New([]any{0, 3}, "number"), "bool", CastOptions{}, []any{false, true}},
+		{"bool to number", New([]any{true, false}, "bool"), "number", CastOptions{}, []any{1, 0}},
+		{"date layout", New([]any{"01/03/2024"}, "string"), "date", CastOptions{DateLayout: "02/01/2006"}, []any{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}},
+		{"date to string", New([]any{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}, "date"), "string", CastOptions{}, []any{"2024-03-01T00:00:00Z"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := testCase.s.Cast(testCase.t, testCase.opts)
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if got.Type() != testCase.t {
+				tt.Errorf("Expected type %v, got %v", testCase.t, got.Type())
+			}
+			if !is.SameSlice(got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_CastStrict(t *testing.T) {
+	s := New([]string{"1", "x", "3", "4.5"}, "string")
+	_, err := s.Cast("number", CastOptions{Strict: true})
+	var castErr *CastError
+	if !errors.As(err, &castErr) {
+		t.Fatalf("Expected a *CastError, got %v", err)
+	}
+	if !is.SameSlice(castErr.Indices, []int{1, 3}) {
+		t.Errorf("Expected indices [1 3], got %v", castErr.Indices)
+	}
+	for _, value := range []string{"Inf", "1e30"} {
+		_, err := New([]string{value}, "string").Cast("number", CastOptions{Strict: true})
+		if !errors.As(err, &castErr) {
+			t.Errorf("Expected a *CastError for %q, got %v", value, err)
+		}
+	}
+	if _, err := s.Cast("unknown", CastOptions{}); err == nil {
+		t.Errorf("Expected an error for an unknown type")
+	}
+}
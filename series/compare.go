@@ -0,0 +1,179 @@
+package series
+
+import "fmt"
+
+// Gt returns a bool Series, true where the value is greater than other.
+// other is either a Series (of any element type) with the same length, or a scalar
+// compared to every element. Numbers compare with floats and "date" values with
+// date strings. Comparisons involving a null are false. It panics when the types
+// cannot be compared or the lengths differ.
+// The result plugs directly into ApplyBoolStatement.
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	s.Gt(1) // return Series of type bool with values [false, true, true]
+func (s Series[T]) Gt(other any) Series[bool] {
+	return s.compare(">", other)
+}
+
+// Ge returns a bool Series, true where the value is greater than or equal to other (see Gt).
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	s.Ge(2) // return Series of type bool with values [false, true, true]
+func (s Series[T]) Ge(other any) Series[bool] {
+	return s.compare(">=", other)
+}
+
+// Lt returns a bool Series, true where the value is less than other (see Gt).
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	s.Lt(2) // return Series of type bool with values [true, false, false]
+func (s Series[T]) Lt(other any) Series[bool] {
+	return s.compare("<", other)
+}
+
+// Le returns a bool Series, true where the value is less than or equal to other (see Gt).
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	s.Le(2) // return Series of type bool with values [true, true, false]
+func (s Series[T]) Le(other any) Series[bool] {
+	return s.compare("<=", other)
+}
+
+// Eq returns a bool Series, true where the value equals other (see Gt).
+// Examples:
+//
+//	s := series.New([]string{"a", "b"}, "string")
+//	s.Eq("b") // return Series of type bool with values [false, true]
+func (s Series[T]) Eq(other any) Series[bool] {
+	return s.compare("==", other)
+}
+
+// Ne returns a bool Series, true where the value differs from other (see Gt).
+// Examples:
+//
+//	s := series.New([]string{"a", "b"}, "string")
+//	s.Ne("b") // return Series of type bool with values [true, false]
+func (s Series[T]) Ne(other any) Series[bool] {
+	return s.compare("!=", other)
+}
+
+// Between returns a bool Series, true where lower <= value <= upper (see Gt).
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3, 4}, "number")
+//	s.Between(2, 3) // return Series of type bool with values [false, true, true, false]
+func (s Series[T]) Between(lower any, upper any) Series[bool] {
+	return And(s.Ge(lower), s.Le(upper))
+}
+
+// IsIn returns a bool Series, true where the value equals one of values.
+// Values that cannot be compared with the Series type never match.
+// Examples:
+//
+//	s := series.New([]string{"FR", "DE", "BE"}, "string")
+//	s.IsIn("FR", "BE") // return Series of type bool with values [true, false, true]
+func (s Series[T]) IsIn(values ...any) Series[bool] {
+	out := make([]bool, len(s.data))
+	for i, value := range s.data {
+		a := any(value)
+		if a == nil {
+			continue
+		}
+		for _, b := range values {
+			t, ok := CompareType(s.t, scalarType(b))
+			if !ok || b == nil {
+				continue
+			}
+			if c, ok := CompareValues(a, b, t); ok && c == 0 {
+				out[i] = true
+				break
+			}
+		}
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// compare applies a comparison operator element-wise between the Series and other.
+func (s Series[T]) compare(op string, other any) Series[bool] {
+	get, otherType := broadcast(other, len(s.data))
+	t, ok := CompareType(s.t, otherType)
+	if !ok || (t == "bool" && op != "==" && op != "!=") {
+		panic(fmt.Sprintf("operator %s not defined on %s and %s", op, s.t, otherType))
+	}
+	out := make([]bool, len(s.data))
+	for i, value := range s.data {
+		a, b := any(value), get(i)
+		if a == nil || b == nil {
+			continue
+		}
+		c, ok := CompareValues(a, b, t)
+		if !ok {
+			continue
+		}
+		switch op {
+		case ">":
+			out[i] = c > 0
+		case ">=":
+			out[i] = c >= 0
+		case "<":
+			out[i] = c < 0
+		case "<=":
+			out[i] = c <= 0
+		case "==":
+			out[i] = c == 0
+		default:
+			out[i] = c != 0
+		}
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// And returns the element-wise logical AND of bool Series.
+// It panics if the lengths differ.
+// Examples:
+//
+//	a := series.New([]bool{true, true, false}, "bool")
+//	b := series.New([]bool{true, false, false}, "bool")
+//	series.And(a, b) // return Series of type bool with values [true, false, false]
+func And(a Series[bool], b Series[bool]) Series[bool] {
+	return combineBool(a, b, func(x, y bool) bool { return x && y })
+}
+
+// Or returns the element-wise logical OR of bool Series.
+// It panics if the lengths differ.
+// Examples:
+//
+//	a := series.New([]bool{true, true, false}, "bool")
+//	b := series.New([]bool{true, false, false}, "bool")
+//	series.Or(a, b) // return Series of type bool with values [true, true, false]
+func Or(a Series[bool], b Series[bool]) Series[bool] {
+	return combineBool(a, b, func(x, y bool) bool { return x || y })
+}
+
+// Not returns the element-wise logical negation of a bool Series.
+// Examples:
+//
+//	series.Not(series.New([]bool{true, false}, "bool")) // return Series of type bool with values [false, true]
+func Not(a Series[bool]) Series[bool] {
+	out := make([]bool, len(a.data))
+	for i, v := range a.data {
+		out[i] = !v
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// combineBool applies fn element-wise to two bool Series of the same length.
+func combineBool(a Series[bool], b Series[bool], fn func(x, y bool) bool) Series[bool] {
+	if len(a.data) != len(b.data) {
+		panic(fmt.Sprintf("length mismatch: %d and %d", len(a.data), len(b.data)))
+	}
+	out := make([]bool, len(a.data))
+	for i := range a.data {
+		out[i] = fn(a.data[i], b.data[i])
+	}
+	return Series[bool]{out, "bool"}
+}
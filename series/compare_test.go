@@ -0,0 +1,52 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Compare(t *testing.T) {
+	numbers := NewNullable([]any{1, 2, nil, 4}, "number")
+	large := New([]int{1 << 60, 1<<60 + 1}, "number")
+	dates := New([]string{"2024-01-01", "2024-02-01", "2024-03-01", "2024-04-01"}, "date")
+	testCases := []struct {
+		name     string
+		got      Series[bool]
+		expected []bool
+	}{
+		{"gt scalar", numbers.Gt(1), []bool{false, true, false, true}},
+		{"ge float", numbers.Ge(2.0), []bool{false, true, false, true}},
+		{"lt series", numbers.Lt(New([]float64{2, 2, 2, 2}, "float")), []bool{true, false, false, false}},
+		{"le", numbers.Le(2), []bool{true, true, false, false}},
+		{"eq", numbers.Eq(4), []bool{false, false, false, true}},
+		{"ne", numbers.Ne(4), []bool{true, true, false, false}},
+		{"between", numbers.Between(2, 4), []bool{false, true, false, true}},
+		{"eq large ints", large.Eq(1 << 60), []bool{true, false}},
+		{"gt large ints", large.Gt(1 << 60), []bool{false, true}},
+		{"is in large ints", large.IsIn(1<<60 + 1), []bool{false, true}},
+		{"is in", New([]string{"FR", "DE", "BE"}, "string").IsIn("FR", "BE", 3), []bool{true, false, true}},
+		{"date with string", dates.Ge("2024-02-15"), []bool{false, false, true, true}},
+		{"and", And(numbers.Gt(1), numbers.Lt(4)), []bool{false, true, false, false}},
+		{"or", Or(numbers.Lt(2), numbers.Gt(3)), []bool{true, false, false, true}},
+		{"not", Not(numbers.IsNull()), []bool{true, true, false, true}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if testCase.got.Type() != "bool" {
+				tt.Errorf("Expected type bool, got %v", testCase.got.Type())
+			}
+			if !is.SameSlice(testCase.got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_CompareApplyBoolStatement(t *testing.T) {
+	s := New([]int{5, 12, 18, 30}, "number")
+	got := s.ApplyBoolStatement(s.Between(10, 20))
+	if !is.SameSlice(got.ToSlice(), []int{12, 18}) {
+		t.Errorf("Expected %v, got %v", []int{12, 18}, got.ToSlice())
+	}
+}
@@ -0,0 +1,128 @@
+package series
+
+import "fmt"
+
+// CumSum returns the running sum of a numeric Series. A "number" Series gives a
+// "number" result, unless one of its values is not an int, and a "float" Series a
+// "float" result. Nulls are skipped: their
+// position is null and the sum carries on with the next value. It panics if the
+// Series is not numeric.
+// Examples:
+//
+//	s := series.NewNullable([]any{1, 2, nil, 4}, "number")
+//	s.CumSum() // [1, 3, <nil>, 7]
+func (s Series[T]) CumSum() Series[any] {
+	return s.cumulateNumeric("CumSum", func(acc int, v int) int { return acc + v }, func(acc float64, v float64) float64 { return acc + v })
+}
+
+// CumProd returns the running product of a numeric Series (see CumSum for types and nulls).
+// Examples:
+//
+//	s := series.New([]float64{1, 2, 1.5}, "float")
+//	s.CumProd() // [1, 2, 3]
+func (s Series[T]) CumProd() Series[any] {
+	return s.cumulateNumeric("CumProd", func(acc int, v int) int { return acc * v }, func(acc float64, v float64) float64 { return acc * v })
+}
+
+// CumMax returns the running maximum of a Series of any type, keeping its type tag.
+// Nulls are skipped: their position is null.
+// Examples:
+//
+//	s := series.New([]int{1, 3, 2, 5}, "number")
+//	s.CumMax() // [1, 3, 3, 5]
+func (s Series[T]) CumMax() Series[any] {
+	return s.cumulate(func(acc any, v any) any {
+		if c, ok := CompareValues(v, acc, s.t); acc == nil || ok && c > 0 {
+			return v
+		}
+		return acc
+	})
+}
+
+// CumMin returns the running minimum of a Series of any type, keeping its type tag.
+// Nulls are skipped: their position is null.
+// Examples:
+//
+//	s := series.New([]string{"b", "c", "a"}, "string")
+//	s.CumMin() // [b, b, a]
+func (s Series[T]) CumMin() Series[any] {
+	return s.cumulate(func(acc any, v any) any {
+		if c, ok := CompareValues(v, acc, s.t); acc == nil || ok && c < 0 {
+			return v
+		}
+		return acc
+	})
+}
+
+// CumCount returns a "number" Series with the running count of non-null values.
+// Examples:
+//
+//	s := series.NewNullable([]any{"a", nil, "b"}, "string")
+//	s.CumCount() // [1, 1, 2]
+func (s Series[T]) CumCount() Series[int] {
+	out := make([]int, len(s.data))
+	count := 0
+	for i, v := range s.data {
+		if !isNull(v) {
+			count++
+		}
+		out[i] = count
+	}
+	return Series[int]{out, "number"}
+}
+
+// cumulateNumeric runs a cumulative operation computed as int for "number" Series and
+// as float64 for "float" Series. A "number" Series holding a value that is not an int
+// (a fraction or an integer out of the int range) is computed as float64 and gives a
+// "float" result.
+func (s Series[T]) cumulateNumeric(name string, intOp func(acc int, v int) int, floatOp func(acc float64, v float64) float64) Series[any] {
+	t := s.t
+	if t == "number" {
+		for _, v := range s.data {
+			if _, ok := ToInt(v); !ok && !isNull(v) {
+				t = "float"
+				break
+			}
+		}
+	}
+	switch t {
+	case "number":
+		return s.cumulate(func(acc any, v any) any {
+			y, _ := ToInt(v)
+			if acc == nil {
+				return y
+			}
+			return intOp(acc.(int), y)
+		})
+	case "float":
+		out := s.cumulate(func(acc any, v any) any {
+			y, ok := ToFloat(v)
+			if !ok {
+				panic(fmt.Sprintf("%s: cannot use %v (%T) as %s", name, v, v, s.t))
+			}
+			if acc == nil {
+				return y
+			}
+			return floatOp(acc.(float64), y)
+		})
+		out.t = "float"
+		return out
+	}
+	panic(fmt.Sprintf("%s not defined on %s", name, s.t))
+}
+
+// cumulate returns the successive values of step(acc, value) over the non-null values.
+// acc is nil for the first non-null value. Null positions stay null.
+func (s Series[T]) cumulate(step func(acc any, v any) any) Series[any] {
+	out := make([]any, len(s.data))
+	var acc any
+	for i, v := range s.data {
+		value := any(v)
+		if isNull(value) {
+			continue
+		}
+		acc = step(acc, value)
+		out[i] = acc
+	}
+	return Series[any]{out, s.t}
+}
@@ -0,0 +1,58 @@
+package series
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Cumulative(t *testing.T) {
+	d1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	numbers := NewNullable([]any{2, nil, 3, int64(1)}, "number")
+	testCases := []struct {
+		name     string
+		got      Series[any]
+		t        string
+		expected []any
+	}{
+		{"cumsum number", numbers.CumSum(), "number", []any{2, nil, 5, 6}},
+		{"cumsum float", New([]float64{0.5, 1, 1.5}, "float").CumSum(), "float", []any{0.5, 1.5, 3.0}},
+		{"cumprod", numbers.CumProd(), "number", []any{2, nil, 6, 6}},
+		{"cummax", numbers.CumMax(), "number", []any{2, nil, 3, 3}},
+		{"cummin", numbers.CumMin(), "number", []any{2, nil, 2, int64(1)}},
+		{"cummax large ints", New([]int{1 << 60, 1<<60 + 1, 1 << 60}, "number").CumMax(), "number", []any{1 << 60, 1<<60 + 1, 1<<60 + 1}},
+		{"cummin string", New([]string{"b", "c", "a"}, "string").CumMin(), "string", []any{"b", "b", "a"}},
+		{"cummax date", New([]time.Time{d2, d1}, "date").CumMax(), "date", []any{d2, d2}},
+		{"leading null", NewNullable([]any{nil, 1.5}, "float").CumSum(), "float", []any{nil, 1.5}},
+		{"empty", New([]int{}, "number").CumSum(), "number", []any{}},
+		{"number out of the int range", Series[any]{[]any{1, uint64(math.MaxUint64)}, "number"}.CumSum(), "float", []any{1.0, 1 + float64(math.MaxUint64)}},
+		{"fraction in a number series", Series[any]{[]any{1, nil, 2.5}, "number"}.CumProd(), "float", []any{1.0, nil, 2.5}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := testCase.got.ToSlice()
+			if len(got) != len(testCase.expected) || !is.SameSlice(got, testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+			if testCase.got.Type() != testCase.t {
+				tt.Errorf("Expected type %v, got %v", testCase.t, testCase.got.Type())
+			}
+		})
+	}
+}
+
+func TestSeries_CumCount(t *testing.T) {
+	got := NewNullable([]any{"a", nil, "b"}, "string").CumCount()
+	if !is.SameSlice(got.ToSlice(), []int{1, 1, 2}) || got.Type() != "number" {
+		t.Errorf("Expected number [1 1 2], got %v %v", got.Type(), got.ToSlice())
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic on a string Series")
+		}
+	}()
+	New([]string{"a"}, "string").CumSum()
+}
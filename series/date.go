@@ -0,0 +1,43 @@
+package series
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateLayouts lists the layouts tried, in order, when a "date" value stored as a string
+// has to be parsed (see ParseDate).
+var DateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ParseDate converts a value of a "date" Series into a time.Time.
+// Dates are either stored as time.Time or as strings, in which case every layout of
+// DateLayouts is tried. Strings without a time zone are parsed as UTC.
+// Examples:
+//
+//	series.ParseDate("2024-03-01")          // 2024-03-01 00:00:00 +0000 UTC
+//	series.ParseDate("2024-03-01T10:30:00") // 2024-03-01 10:30:00 +0000 UTC
+func ParseDate(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v != nil {
+			return *v, nil
+		}
+	case string:
+		s := strings.TrimSpace(v)
+		for _, layout := range DateLayouts {
+			if tm, err := time.Parse(layout, s); err == nil {
+				return tm, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("cannot parse %q as a date", v)
+	}
+	return time.Time{}, fmt.Errorf("cannot use %v (%T) as a date", value, value)
+}
@@ -0,0 +1,33 @@
+package series
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeries_ParseDate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    any
+		expected time.Time
+		wantErr  bool
+	}{
+		{"date only", "2024-03-01", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"excel iso", "2024-03-01T10:30:00", time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC), false},
+		{"rfc3339", "2024-03-01T10:30:00Z", time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC), false},
+		{"time value", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"invalid string", "01/03/2024", time.Time{}, true},
+		{"invalid type", 12, time.Time{}, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := ParseDate(testCase.value)
+			if (err != nil) != testCase.wantErr {
+				tt.Fatalf("Expected error %v, got %v", testCase.wantErr, err)
+			}
+			if !got.Equal(testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package series
+
+import "github.com/visual-pivert/go-starter/is"
+
+// Equals returns true if both Series have the same type tag and the same values in the
+// same order (see is.Equal; nulls equal nulls).
+// Examples:
+//
+//	a := series.New([]int{1, 2}, "number")
+//	a.Equals(series.New([]int{1, 2}, "number")) // true
+//	a.Equals(series.New([]int{1, 2}, "float"))  // false
+func (s Series[T]) Equals(other Series[T]) bool {
+	return s.t == other.t && is.Equal(s.data, other.data)
+}
@@ -0,0 +1,27 @@
+package series
+
+import "testing"
+
+func TestSeries_Equals(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value1   Series[any]
+		value2   Series[any]
+		expected bool
+	}{
+		{"same values", New([]any{1, "a"}, "string"), New([]any{1, "a"}, "string"), true},
+		{"different type tag", New([]any{1, 2}, "number"), New([]any{1, 2}, "float"), false},
+		{"different values", New([]any{1, 2}, "number"), New([]any{2, 1}, "number"), false},
+		{"nulls", NewNullable([]any{nil, 1}, "number"), NewNullable([]any{nil, 1}, "number"), true},
+		{"null and zero", NewNullable([]any{nil}, "number"), New([]any{nil}, "number"), false},
+		{"empty", New([]any{}, "number"), New[any](nil, "number"), true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := testCase.value1.Equals(testCase.value2)
+			if got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package series
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+// jsonSeries is the JSON representation of a Series.
+type jsonSeries struct {
+	Type string            `json:"type"`
+	Data []json.RawMessage `json:"data"`
+}
+
+// MarshalJSON encodes the Series as an object holding its type tag and its values,
+// encoded as by JSONValue.
+// Examples:
+//
+//	s := series.NewNullable([]any{1, nil}, "number")
+//	json.Marshal(s) // {"type":"number","data":[1,null]}
+func (s Series[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"type":`)
+	buf.WriteString(strconv.Quote(s.t))
+	buf.WriteString(`,"data":[`)
+	for i, v := range s.data {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		b, err := json.Marshal(JSONValue(v, s.t))
+		if err != nil {
+			return nil, fmt.Errorf("series: index %d: %w", i, err)
+		}
+		buf.Write(b)
+	}
+	buf.WriteString("]}")
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a Series encoded by MarshalJSON. Values are decoded as by
+// DecodeJSONValue; nulls become nil in a Series[any] and the zero value otherwise.
+// Examples:
+//
+//	var s series.Series[any]
+//	err := json.Unmarshal([]byte(`{"type":"date","data":["2024-01-02T00:00:00Z"]}`), &s)
+func (s *Series[T]) UnmarshalJSON(data []byte) error {
+	var raw jsonSeries
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if !is.In(raw.Type, typePossibilities) {
+		return fmt.Errorf("series: unknown type %q", raw.Type)
+	}
+	out := make([]T, len(raw.Data))
+	for i, item := range raw.Data {
+		v, err := DecodeJSONValue(item, raw.Type)
+		if err != nil {
+			return fmt.Errorf("series: index %d: %w", i, err)
+		}
+		if v == nil {
+			continue
+		}
+		if typed, ok := v.(T); ok {
+			out[i] = typed
+		} else if err := json.Unmarshal(item, &out[i]); err != nil {
+			return fmt.Errorf("series: index %d: %w", i, err)
+		}
+	}
+	*s = Series[T]{out, raw.Type}
+	return nil
+}
+
+// jsonFloats maps the strings encoding the float values JSON has no number for.
+var jsonFloats = map[string]float64{"NaN": math.NaN(), "Infinity": math.Inf(1), "-Infinity": math.Inf(-1)}
+
+// JSONValue returns the value to encode in JSON for a value of type t: nil for nulls, the
+// strings "NaN", "Infinity" and "-Infinity" for the floats JSON has no number for, an
+// RFC 3339 string for dates, the value itself otherwise.
+// Examples:
+//
+//	series.JSONValue(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), "date") // "2024-01-02T00:00:00Z"
+//	series.JSONValue(math.Inf(-1), "float")                              // "-Infinity"
+func JSONValue(v any, t string) any {
+	if isNull(v) {
+		return nil
+	}
+	if f, ok := v.(float64); ok {
+		switch {
+		case math.IsNaN(f):
+			return "NaN"
+		case math.IsInf(f, 1):
+			return "Infinity"
+		case math.IsInf(f, -1):
+			return "-Infinity"
+		}
+	}
+	if t == "date" {
+		if tm, err := ParseDate(v); err == nil {
+			return tm.Format(time.RFC3339Nano)
+		}
+	}
+	return v
+}
+
+// DecodeJSONValue decodes a JSON value as the canonical Go value of type t: int for
+// "number", float64 for "float" (from a number or one of the strings "NaN", "Infinity"
+// and "-Infinity"), bool for "bool", time.Time for "date" (RFC 3339 or a layout of
+// DateLayouts) and string for "string". JSON null gives nil.
+// Examples:
+//
+//	series.DecodeJSONValue(json.RawMessage(`12`), "number") // 12, nil
+//	series.DecodeJSONValue(json.RawMessage(`"x"`), "number") // nil, error
+func DecodeJSONValue(raw json.RawMessage, t string) (any, error) {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return nil, nil
+	}
+	switch t {
+	case "number":
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return nil, err
+		}
+		i, err := strconv.Atoi(n.String())
+		if err != nil {
+			return nil, fmt.Errorf("cannot use %s as number", raw)
+		}
+		return i, nil
+	case "float":
+		var str string
+		if json.Unmarshal(raw, &str) == nil {
+			if f, ok := jsonFloats[str]; ok {
+				return f, nil
+			}
+		}
+		return decodeJSON[float64](raw)
+	case "bool":
+		return decodeJSON[bool](raw)
+	case "date":
+		str, err := decodeJSON[string](raw)
+		if err != nil {
+			return nil, err
+		}
+		return ParseDate(str)
+	default:
+		return decodeJSON[string](raw)
+	}
+}
+
+// InferJSON returns a nullable Series of values decoded by a json.Decoder using UseNumber
+// (nil, bool, json.Number and string), with the type they share: "bool", "number" for
+// integers, "float" for numbers and the strings "NaN", "Infinity" and "-Infinity" among
+// them (those strings stay strings in a column without JSON numbers), "date" for strings
+// that all parse as dates, "string" for other or mixed values.
+// Values are converted to the type, nested values (any other Go value) are formatted.
+// Examples:
+//
+//	series.InferJSON([]any{json.Number("1"), nil})      // number [1, <nil>]
+//	series.InferJSON([]any{json.Number("1"), "NaN"})    // float [1, NaN]
+//	series.InferJSON([]any{"2024-01-02", "2024-01-03"}) // date
+func InferJSON(values []any) Series[any] {
+	kinds := map[string]bool{}
+	for _, v := range values {
+		switch value := v.(type) {
+		case nil:
+		case bool:
+			kinds["bool"] = true
+		case json.Number:
+			if _, err := strconv.Atoi(value.String()); err == nil {
+				kinds["number"] = true
+			} else {
+				kinds["float"] = true
+			}
+		case string:
+			if _, ok := jsonFloats[value]; ok {
+				kinds["special"] = true
+			} else if _, err := ParseDate(value); err == nil {
+				kinds["date"] = true
+			} else {
+				kinds["string"] = true
+			}
+		default:
+			kinds["string"] = true
+		}
+	}
+	t := "string"
+	switch {
+	case len(kinds) == 1 && kinds["bool"]:
+		t = "bool"
+	case len(kinds) == 1 && kinds["number"]:
+		t = "number"
+	case len(kinds) == 1 && kinds["date"]:
+		t = "date"
+	case (kinds["number"] || kinds["float"]) && !kinds["bool"] && !kinds["date"] && !kinds["string"]:
+		t = "float"
+	}
+	out := make([]any, len(values))
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		switch t {
+		case "number":
+			out[i], _ = strconv.Atoi(v.(json.Number).String())
+		case "float":
+			if n, ok := v.(json.Number); ok {
+				out[i], _ = n.Float64()
+			} else {
+				out[i] = jsonFloats[v.(string)]
+			}
+		case "date":
+			out[i], _ = ParseDate(v)
+		case "string":
+			out[i] = fmt.Sprint(v)
+		default:
+			out[i] = v
+		}
+	}
+	return Series[any]{out, t}
+}
+
+// decodeJSON decodes raw as a U and returns it as an interface value, nil on error.
+func decodeJSON[U any](raw json.RawMessage) (any, error) {
+	var v U
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,113 @@
+package series
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSeries_MarshalJSON(t *testing.T) {
+	day := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"number with null", NewNullable([]any{1, nil}, "number"), `{"type":"number","data":[1,null]}`},
+		{"date", New([]time.Time{day}, "date"), `{"type":"date","data":["2024-01-02T03:04:05Z"]}`},
+		{"legacy date string", New([]string{"2024-01-02"}, "date"), `{"type":"date","data":["2024-01-02T00:00:00Z"]}`},
+		{"NaN", New([]float64{math.NaN(), 1.5}, "float"), `{"type":"float","data":["NaN",1.5]}`},
+		{"infinities", New([]float64{math.Inf(1), math.Inf(-1)}, "float"), `{"type":"float","data":["Infinity","-Infinity"]}`},
+		{"empty", New([]string{}, "string"), `{"type":"string","data":[]}`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got, err := json.Marshal(testCase.value)
+			if err != nil || string(got) != testCase.expected {
+				tt.Errorf("Expected %s, got %s (%v)", testCase.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestSeries_UnmarshalJSON(t *testing.T) {
+	day := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    string
+		expected Series[any]
+		wantErr  bool
+	}{
+		{name: "number", value: `{"type":"number","data":[1,null,3]}`, expected: NewNullable([]any{1, nil, 3}, "number")},
+		{name: "float", value: `{"type":"float","data":[1,2.5]}`, expected: New([]any{1.0, 2.5}, "float")},
+		{name: "date", value: `{"type":"date","data":["2024-01-02T03:04:05Z"]}`, expected: New([]any{day}, "date")},
+		{name: "bool", value: `{"type":"bool","data":[true]}`, expected: New([]any{true}, "bool")},
+		{name: "infinities", value: `{"type":"float","data":["Infinity","-Infinity"]}`, expected: New([]any{math.Inf(1), math.Inf(-1)}, "float")},
+		{name: "wrong value", value: `{"type":"number","data":[1.5]}`, wantErr: true},
+		{name: "unknown float string", value: `{"type":"float","data":["inf"]}`, wantErr: true},
+		{name: "unknown type", value: `{"type":"decimal","data":[]}`, wantErr: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			var got Series[any]
+			err := json.Unmarshal([]byte(testCase.value), &got)
+			if (err != nil) != testCase.wantErr {
+				tt.Fatalf("Expected error %v, got %v", testCase.wantErr, err)
+			}
+			if !testCase.wantErr && !got.Equals(testCase.expected) {
+				tt.Errorf("Expected %v %v, got %v %v", testCase.expected.Type(), testCase.expected.ToSlice(), got.Type(), got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_JSONRoundTrip(t *testing.T) {
+	s := New([]float64{1, 2.5}, "float")
+	b, _ := json.Marshal(s)
+	var got Series[float64]
+	if err := json.Unmarshal(b, &got); err != nil || !got.Equals(s) {
+		t.Errorf("Expected %v, got %v (%v)", s.ToSlice(), got.ToSlice(), err)
+	}
+	var ints Series[int]
+	if err := json.Unmarshal([]byte(`{"type":"number","data":[4,null]}`), &ints); err != nil || !ints.Equals(New([]int{4, 0}, "number")) {
+		t.Errorf("Expected [4 0], got %v (%v)", ints.ToSlice(), err)
+	}
+}
+
+func TestSeries_JSONRoundTripNaN(t *testing.T) {
+	b, _ := json.Marshal(New([]float64{math.NaN(), 1}, "float"))
+	var got Series[any]
+	if err := json.Unmarshal(b, &got); err != nil || got.Len() != 2 || !math.IsNaN(got.GetValue(0).(float64)) {
+		t.Errorf("Expected [NaN 1], got %v (%v)", got.ToSlice(), err)
+	}
+}
+
+func TestSeries_InferJSON(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    []any
+		expected Series[any]
+	}{
+		{"numbers", []any{json.Number("1"), nil}, NewNullable([]any{1, nil}, "number")},
+		{"floats", []any{json.Number("1"), json.Number("2.5")}, New([]any{1.0, 2.5}, "float")},
+		{"infinity among numbers", []any{json.Number("1"), "-Infinity"}, New([]any{1.0, math.Inf(-1)}, "float")},
+		{"infinity alone", []any{"Infinity"}, New([]any{"Infinity"}, "string")},
+		{"NaN with nulls", []any{"NaN", nil, "-Infinity"}, NewNullable([]any{"NaN", nil, "-Infinity"}, "string")},
+		{"NaN among strings", []any{"NaN", "n/a"}, New([]any{"NaN", "n/a"}, "string")},
+		{"NaN among numbers and strings", []any{json.Number("1"), "NaN", "n/a"}, New([]any{"1", "NaN", "n/a"}, "string")},
+		{"bools", []any{true, false}, New([]any{true, false}, "bool")},
+		{"dates", []any{"2024-01-02", nil}, NewNullable([]any{day, nil}, "date")},
+		{"mixed", []any{json.Number("1"), "2024-01-02", true}, New([]any{"1", "2024-01-02", "true"}, "string")},
+		{"nulls", []any{nil, nil}, NewNullable([]any{nil, nil}, "string")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := InferJSON(testCase.value)
+			if !got.Equals(testCase.expected) {
+				tt.Errorf("Expected %v %v, got %v %v", testCase.expected.Type(), testCase.expected.ToSlice(), got.Type(), got.ToSlice())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package series
+
+import "github.com/visual-pivert/go-starter/is"
+
+// NewNullable creates a new Series like New, but nil values are kept as nulls
+// instead of being replaced by the zero value of the type.
+// Param t must be one of "string", "number", "date", "float" or "bool".
+// Examples:
+//
+//	s := series.NewNullable([]any{1, nil, 3}, "number")
+//	s.Debug() // [1, <nil>, 3]
+func NewNullable[T any](data []T, t string) Series[T] {
+	if is.In(t, typePossibilities) == false {
+		panic("type not supported")
+	}
+	coerced, ok := coerceIfAnySlice[T](data, t, true)
+	if ok {
+		return Series[T]{coerced, t}
+	}
+	return Series[T]{data, t}
+}
+
+// IsNull returns a bool Series where true marks a null (nil) value.
+// Examples:
+//
+//	s := series.NewNullable([]any{1, nil, 3}, "number")
+//	s.IsNull() // return Series of type bool with values [false, true, false]
+func (s Series[T]) IsNull() Series[bool] {
+	out := make([]bool, len(s.data))
+	for i, v := range s.data {
+		out[i] = isNull(v)
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// NullCount returns the number of null (nil) values in the Series.
+// Examples:
+//
+//	s := series.NewNullable([]any{1, nil, nil}, "number")
+//	s.NullCount() // return 2
+func (s Series[T]) NullCount() int {
+	counter := 0
+	for _, v := range s.data {
+		if isNull(v) {
+			counter++
+		}
+	}
+	return counter
+}
+
+// FillNull returns a new Series where null values are replaced by value.
+// Examples:
+//
+//	s := series.NewNullable([]any{1, nil, 3}, "number")
+//	s = s.FillNull(0) // return Series of type number with values [1, 0, 3]
+func (s Series[T]) FillNull(value T) Series[T] {
+	out := make([]T, len(s.data))
+	for i, v := range s.data {
+		if isNull(v) {
+			out[i] = value
+			continue
+		}
+		out[i] = v
+	}
+	return Series[T]{out, s.t}
+}
+
+// isNull reports whether v is a null value, that is a nil interface.
+func isNull(v any) bool {
+	return v == nil
+}
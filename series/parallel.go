@@ -0,0 +1,23 @@
+package series
+
+import (
+	"context"
+
+	fnVisual "github.com/visual-pivert/go-starter/fn"
+)
+
+// ParallelMap returns a new Series of the same type with the results of fn, run with at
+// most limit goroutines (see fn.ParallelMap for ordering, cancellation and errors).
+// Examples:
+//
+//	s := series.New([]string{" a", "b "}, "string")
+//	s, err := s.ParallelMap(ctx, 4, func(_ context.Context, v string, _ int) (string, error) {
+//		return strings.TrimSpace(v), nil
+//	}) // Series of type string with values [a, b]
+func (s Series[T]) ParallelMap(ctx context.Context, limit int, fn func(ctx context.Context, value T, idx int) (T, error)) (Series[T], error) {
+	out, err := fnVisual.ParallelMap(ctx, s.data, limit, fn)
+	if err != nil {
+		return Series[T]{}, err
+	}
+	return Series[T]{out, s.t}, nil
+}
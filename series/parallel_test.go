@@ -0,0 +1,33 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_ParallelMap(t *testing.T) {
+	s := New([]string{" a", "b ", " c "}, "string")
+	got, err := s.ParallelMap(context.Background(), 2, func(_ context.Context, v string, _ int) (string, error) {
+		return strings.TrimSpace(v), nil
+	})
+	if err != nil || !is.SameSlice(got.ToSlice(), []string{"a", "b", "c"}) || got.Type() != "string" {
+		t.Errorf("Expected string [a b c], got %v %v (%v)", got.Type(), got.ToSlice(), err)
+	}
+	_, err = s.ParallelMap(context.Background(), 2, func(_ context.Context, v string, idx int) (string, error) {
+		if idx == 2 {
+			return "", fmt.Errorf("bad value %q", v)
+		}
+		return v, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("Expected an error at index 2, got %v", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Errorf("Expected a wrapped error, got %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package series
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/visual-pivert/go-starter/is"
 )
 
+// typePossibilities lists the type tags a Series can carry.
+var typePossibilities = []string{"string", "number", "date", "float", "bool"}
+
 type Series[T any] struct {
 	data []T
 	t    string // "string" or "number" or "date" or "float" or "bool"
@@ -21,11 +26,10 @@ type Series[T any] struct {
 //
 //	series.New([]int{1, 2, 3}, "number") // return Series of type number
 func New[T any](data []T, t string) Series[T] {
-	typePossibilities := []string{"string", "number", "date", "float", "bool"}
 	if is.In(t, typePossibilities) == false {
 		panic("type not supported")
 	}
-	coerced, ok := coerceIfAnySlice[T](data, t)
+	coerced, ok := coerceIfAnySlice[T](data, t, false)
 	if ok {
 		return Series[T]{coerced, t}
 	}
@@ -34,7 +38,8 @@ func New[T any](data []T, t string) Series[T] {
 
 // coerceIfAnySlice attempts to coerce a slice of interface values into a slice of a specified type.
 // If coercion succeeds, it returns the coerced slice and true; otherwise, it returns nil and false.
-func coerceIfAnySlice[T any](data []T, t string) ([]T, bool) {
+// When keepNull is true, nil entries are kept as nulls instead of being replaced by the zero value of t.
+func coerceIfAnySlice[T any](data []T, t string, keepNull bool) ([]T, bool) {
 	rv := reflect.ValueOf(data)
 	if rv.Kind() != reflect.Slice {
 		return nil, false
@@ -52,7 +57,9 @@ func coerceIfAnySlice[T any](data []T, t string) ([]T, bool) {
 	for i := 0; i < n; i++ {
 		elem := rv.Index(i)
 		if elem.Kind() == reflect.Interface && elem.IsNil() {
-			out.Index(i).Set(reflect.ValueOf(zeroForType(t)))
+			if !keepNull {
+				out.Index(i).Set(reflect.ValueOf(zeroForType(t)))
+			}
 			continue
 		}
 		v := elem.Interface() // dynamic value
@@ -245,6 +252,15 @@ func (s Series[T]) ToSlice() []T {
 	return out
 }
 
+// Values returns an iterator over the values of the Series without copying them.
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	for v := range s.Values() {} // 1, 2, 3
+func (s Series[T]) Values() iter.Seq[T] {
+	return slices.Values(s.data)
+}
+
 // Filter returns a new Series containing elements that satisfy the given filtering function.
 // Examples:
 //
@@ -287,6 +303,37 @@ func (s Series[T]) Map(fn func(value T, index int) T) Series[T] {
 	return Series[T]{out, s.t}
 }
 
+// MapErr is like Map with a fallible function: it stops at the first error and returns
+// it as an *fn.IndexError holding the index of the failing element.
+// Examples:
+//
+//	s := series.New([]string{"a", ""}, "string")
+//	_, err := s.MapErr(func(value string, index int) (string, error) {
+//		if value == "" {
+//			return "", errors.New("empty value")
+//		}
+//		return strings.ToUpper(value), nil
+//	}) // err: index 1: empty value
+func (s Series[T]) MapErr(fn func(value T, index int) (T, error)) (Series[T], error) {
+	out, err := fnVisual.MapErr(s.data, fn)
+	if err != nil {
+		return Series[T]{}, err
+	}
+	return Series[T]{out, s.t}, nil
+}
+
+// MapErrAll is like MapErr but calls fn on every element, whatever the errors: failed
+// elements hold the zero value and every error is returned as an *fn.IndexError joined
+// with errors.Join (see fn.MapErrAll).
+// Examples:
+//
+//	s := series.New([]string{"", "b", ""}, "string")
+//	out, err := s.MapErrAll(upper) // out: ["", "B", ""], err: index 0: empty value\nindex 2: empty value
+func (s Series[T]) MapErrAll(fn func(value T, index int) (T, error)) (Series[T], error) {
+	out, err := fnVisual.MapErrAll(s.data, fn)
+	return Series[T]{out, s.t}, err
+}
+
 // MapToBool returns a new bool Series with the results of calling a provided function on every element in the calling Series.
 // True if the function returns true, false otherwise.
 // Examples:
@@ -374,12 +421,16 @@ func (s Series[T]) Reverse() Series[T] {
 }
 
 // Agg returns the result of applying the provided aggregation function to all elements in the Series.
+// An empty Series returns initialValue.
 // Examples:
 //
 //	s := series.New([]int{1, 2, 3}, "number")
 //	s.Agg(0, func(last int, curr int, currIndex int) int { return last + curr }) // return 6
 func (s Series[T]) Agg(initialValue T, fn func(last T, curr T, currIndex int) T) T {
 	out := fnVisual.Reduce(s.data, initialValue, fn)
+	if len(out) == 0 {
+		return initialValue
+	}
 	return out[len(out)-1]
 }
 
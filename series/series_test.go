@@ -1,6 +1,8 @@
 package series
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/visual-pivert/go-starter/is"
@@ -325,6 +327,7 @@ func TestSeries_Reduce(t *testing.T) {
 		expected []int
 	}{
 		{"cumulative sum", []int{1, 2, 3, 4}, "number", 0, func(last int, curr int, idx int) int { return last + curr }, []int{1, 3, 6, 10}},
+		{"empty", []int{}, "number", 0, func(last int, curr int, idx int) int { return last + curr }, []int{}},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(tt *testing.T) {
@@ -348,6 +351,7 @@ func TestSeries_Agg(t *testing.T) {
 		expected int
 	}{
 		{"aggregate sum", []int{1, 2, 3, 4}, "number", 0, func(last int, curr int, idx int) int { return last + curr }, 10},
+		{"empty", []int{}, "number", 5, func(last int, curr int, idx int) int { return last + curr }, 5},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(tt *testing.T) {
@@ -509,3 +513,67 @@ func TestSeries_CountValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSeries_Nullable(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         []any
+		t             string
+		fill          any
+		expectedNulls []bool
+		expectedCount int
+		expectedFill  []any
+	}{
+		{"keep nil", []any{1, nil, "3", nil}, "number", 0, []bool{false, true, false, true}, 2, []any{1, 0, 3, 0}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			s := NewNullable(testCase.value, testCase.t)
+			if !is.SameSlice(s.IsNull().ToSlice(), testCase.expectedNulls) {
+				tt.Errorf("Expected %v, got %v", testCase.expectedNulls, s.IsNull().ToSlice())
+			}
+			if s.NullCount() != testCase.expectedCount {
+				tt.Errorf("Expected %v, got %v", testCase.expectedCount, s.NullCount())
+			}
+			if got := s.FillNull(testCase.fill); !is.SameSlice(got.ToSlice(), testCase.expectedFill) {
+				tt.Errorf("Expected %v, got %v", testCase.expectedFill, got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_MapErr(t *testing.T) {
+	upper := func(value string, index int) (string, error) {
+		if value == "" {
+			return "", errors.New("empty value")
+		}
+		return strings.ToUpper(value), nil
+	}
+	got, err := New([]string{"a", "b"}, "string").MapErr(upper)
+	if err != nil || !is.SameSlice(got.ToSlice(), []string{"A", "B"}) || got.Type() != "string" {
+		t.Errorf("Expected string [A B], got %v %v (%v)", got.Type(), got.ToSlice(), err)
+	}
+	_, err = New([]string{"a", ""}, "string").MapErr(upper)
+	if err == nil || err.Error() != "index 1: empty value" {
+		t.Errorf("Expected an error at index 1, got %v", err)
+	}
+}
+
+func TestSeries_MapErrAll(t *testing.T) {
+	upper := func(value string, index int) (string, error) {
+		if value == "" {
+			return "", errors.New("empty value")
+		}
+		return strings.ToUpper(value), nil
+	}
+	got, err := New([]string{"", "b", ""}, "string").MapErrAll(upper)
+	if !is.SameSlice(got.ToSlice(), []string{"", "B", ""}) || got.Type() != "string" {
+		t.Errorf("Expected string [ B ], got %v %v", got.Type(), got.ToSlice())
+	}
+	if err == nil || err.Error() != "index 0: empty value\nindex 2: empty value" {
+		t.Errorf("Expected errors at index 0 and 2, got %v", err)
+	}
+	if _, err := New([]string{"a"}, "string").MapErrAll(upper); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
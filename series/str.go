@@ -0,0 +1,328 @@
+package series
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringMethods exposes string operations over a "string" Series (see Series.Str).
+// Transformations return a Series of the same element type and keep nulls;
+// predicates return a bool Series where nulls are false, ready for ApplyBoolStatement.
+type StringMethods[T any] struct {
+	s Series[T]
+}
+
+// Str returns the string operations of a "string" Series.
+// It panics if the Series is not of type "string" or if its element type cannot hold
+// strings (string, a named string type or any).
+// Examples:
+//
+//	s := series.New([]string{" Ana ", "bob"}, "string")
+//	s.Str().TrimSpace().Str().Upper() // return Series of type string with values ["ANA", "BOB"]
+func (s Series[T]) Str() StringMethods[T] {
+	if s.t != "string" {
+		panic(fmt.Sprintf("string methods not defined on %s", s.t))
+	}
+	if kind := reflect.TypeFor[T]().Kind(); kind != reflect.String && kind != reflect.Interface {
+		panic(fmt.Sprintf("string methods not defined on elements of type %s", reflect.TypeFor[T]()))
+	}
+	return StringMethods[T]{s}
+}
+
+// Upper returns a Series with every value in upper case.
+// Examples:
+//
+//	series.New([]string{"ana"}, "string").Str().Upper() // ["ANA"]
+func (m StringMethods[T]) Upper() Series[T] {
+	return m.transform(strings.ToUpper)
+}
+
+// Lower returns a Series with every value in lower case.
+// Examples:
+//
+//	series.New([]string{"ANA"}, "string").Str().Lower() // ["ana"]
+func (m StringMethods[T]) Lower() Series[T] {
+	return m.transform(strings.ToLower)
+}
+
+// Title returns a Series where each word starts with an upper case letter and
+// continues in lower case.
+// Examples:
+//
+//	series.New([]string{"jean-PAUL dupont"}, "string").Str().Title() // ["Jean-Paul Dupont"]
+func (m StringMethods[T]) Title() Series[T] {
+	return m.transform(func(v string) string {
+		var b strings.Builder
+		inWord := false
+		for _, r := range v {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				if inWord {
+					b.WriteRune(unicode.ToLower(r))
+				} else {
+					b.WriteRune(unicode.ToUpper(r))
+				}
+				inWord = true
+				continue
+			}
+			inWord = false
+			b.WriteRune(r)
+		}
+		return b.String()
+	})
+}
+
+// TrimSpace returns a Series with leading and trailing white space removed.
+// Examples:
+//
+//	series.New([]string{"  ana "}, "string").Str().TrimSpace() // ["ana"]
+func (m StringMethods[T]) TrimSpace() Series[T] {
+	return m.transform(strings.TrimSpace)
+}
+
+// Trim returns a Series with leading and trailing characters contained in cutset removed.
+// Examples:
+//
+//	series.New([]string{"--ana--"}, "string").Str().Trim("-") // ["ana"]
+func (m StringMethods[T]) Trim(cutset string) Series[T] {
+	return m.transform(func(v string) string { return strings.Trim(v, cutset) })
+}
+
+// TrimLeft returns a Series with leading characters contained in cutset removed.
+// Examples:
+//
+//	series.New([]string{"00042"}, "string").Str().TrimLeft("0") // ["42"]
+func (m StringMethods[T]) TrimLeft(cutset string) Series[T] {
+	return m.transform(func(v string) string { return strings.TrimLeft(v, cutset) })
+}
+
+// TrimRight returns a Series with trailing characters contained in cutset removed.
+// Examples:
+//
+//	series.New([]string{"ana;;"}, "string").Str().TrimRight(";") // ["ana"]
+func (m StringMethods[T]) TrimRight(cutset string) Series[T] {
+	return m.transform(func(v string) string { return strings.TrimRight(v, cutset) })
+}
+
+// TrimPrefix returns a Series with the given prefix removed where present.
+// Examples:
+//
+//	series.New([]string{"ID-42"}, "string").Str().TrimPrefix("ID-") // ["42"]
+func (m StringMethods[T]) TrimPrefix(prefix string) Series[T] {
+	return m.transform(func(v string) string { return strings.TrimPrefix(v, prefix) })
+}
+
+// TrimSuffix returns a Series with the given suffix removed where present.
+// Examples:
+//
+//	series.New([]string{"42 EUR"}, "string").Str().TrimSuffix(" EUR") // ["42"]
+func (m StringMethods[T]) TrimSuffix(suffix string) Series[T] {
+	return m.transform(func(v string) string { return strings.TrimSuffix(v, suffix) })
+}
+
+// Contains returns a bool Series, true where the value contains substr.
+// Examples:
+//
+//	series.New([]string{"apple", "pear"}, "string").Str().Contains("pp") // [true, false]
+func (m StringMethods[T]) Contains(substr string) Series[bool] {
+	return m.predicate(func(v string) bool { return strings.Contains(v, substr) })
+}
+
+// StartsWith returns a bool Series, true where the value starts with prefix.
+// Examples:
+//
+//	series.New([]string{"FR-01", "BE-02"}, "string").Str().StartsWith("FR") // [true, false]
+func (m StringMethods[T]) StartsWith(prefix string) Series[bool] {
+	return m.predicate(func(v string) bool { return strings.HasPrefix(v, prefix) })
+}
+
+// EndsWith returns a bool Series, true where the value ends with suffix.
+// Examples:
+//
+//	series.New([]string{"a.csv", "b.xlsx"}, "string").Str().EndsWith(".csv") // [true, false]
+func (m StringMethods[T]) EndsWith(suffix string) Series[bool] {
+	return m.predicate(func(v string) bool { return strings.HasSuffix(v, suffix) })
+}
+
+// Match returns a bool Series, true where the value matches the regular expression.
+// Examples:
+//
+//	re := regexp.MustCompile(`^\d{5}$`)
+//	series.New([]string{"75001", "750"}, "string").Str().Match(re) // [true, false]
+func (m StringMethods[T]) Match(re *regexp.Regexp) Series[bool] {
+	return m.predicate(re.MatchString)
+}
+
+// Extract returns a Series with the given capture group of the first match of the
+// regular expression (group 0 is the whole match). Values without match become null
+// (the zero value for non-interface element types).
+// Examples:
+//
+//	re := regexp.MustCompile(`(\d+) EUR`)
+//	series.New([]string{"42 EUR", "free"}, "string").Str().Extract(re, 1) // ["42", ""]
+func (m StringMethods[T]) Extract(re *regexp.Regexp, group int) Series[T] {
+	out := make([]T, len(m.s.data))
+	for i, value := range m.s.data {
+		v, ok := stringValue(value)
+		if !ok {
+			continue
+		}
+		match := re.FindStringSubmatch(v)
+		if group < len(match) && match != nil {
+			out[i] = fromString[T](match[group])
+		}
+	}
+	return Series[T]{out, m.s.t}
+}
+
+// Replace returns a Series where every match of the regular expression is replaced by
+// repl, which may refer to capture groups with $1, ${name}, etc.
+// Examples:
+//
+//	re := regexp.MustCompile(`\s+`)
+//	series.New([]string{"a  b   c"}, "string").Str().Replace(re, " ") // ["a b c"]
+func (m StringMethods[T]) Replace(re *regexp.Regexp, repl string) Series[T] {
+	return m.transform(func(v string) string { return re.ReplaceAllString(v, repl) })
+}
+
+// Split splits every value around sep and returns n Series: the i-th Series holds the
+// i-th part of each value, the last one holding the unsplit remainder (as strings.SplitN).
+// Missing parts become null. As with strings.SplitN, n == 0 returns no Series and n < 0
+// splits around every sep, returning as many Series as the value with the most parts.
+// The result can be appended as Dataframe columns (see df.SplitColumn).
+// Examples:
+//
+//	parts := series.New([]string{"Ana Smith", "Bob"}, "string").Str().Split(" ", 2)
+//	// parts[0]: ["Ana", "Bob"], parts[1]: ["Smith", ""]
+func (m StringMethods[T]) Split(sep string, n int) []Series[T] {
+	split := make([][]string, len(m.s.data))
+	count := max(n, 0)
+	for i, value := range m.s.data {
+		if v, ok := stringValue(value); ok {
+			split[i] = strings.SplitN(v, sep, n)
+			count = max(count, len(split[i]))
+		}
+	}
+	out := make([]Series[T], count)
+	for p := range out {
+		out[p] = Series[T]{make([]T, len(m.s.data)), m.s.t}
+	}
+	for i, parts := range split {
+		for p, part := range parts {
+			out[p].data[i] = fromString[T](part)
+		}
+	}
+	return out
+}
+
+// Pad returns a Series where values shorter than width (in runes) are padded with the
+// fill rune. side is "left", "right" or "both" (extra fill goes to the right).
+// Examples:
+//
+//	series.New([]string{"42"}, "string").Str().Pad(5, "left", '0') // ["00042"]
+func (m StringMethods[T]) Pad(width int, side string, fill rune) Series[T] {
+	return m.transform(func(v string) string {
+		missing := width - utf8.RuneCountInString(v)
+		if missing <= 0 {
+			return v
+		}
+		switch side {
+		case "left":
+			return strings.Repeat(string(fill), missing) + v
+		case "both":
+			left := missing / 2
+			return strings.Repeat(string(fill), left) + v + strings.Repeat(string(fill), missing-left)
+		default:
+			return v + strings.Repeat(string(fill), missing)
+		}
+	})
+}
+
+// Slice returns a Series with the runes of each value between start (inclusive) and
+// end (exclusive). Negative positions count from the end of the value and positions
+// are clamped to the value length.
+// Examples:
+//
+//	series.New([]string{"2024-03-01"}, "string").Str().Slice(0, 4)  // ["2024"]
+//	series.New([]string{"2024-03-01"}, "string").Str().Slice(-2, 10) // ["01"]
+func (m StringMethods[T]) Slice(start int, end int) Series[T] {
+	return m.transform(func(v string) string {
+		runes := []rune(v)
+		clamp := func(pos int) int {
+			if pos < 0 {
+				pos += len(runes)
+			}
+			return max(0, min(pos, len(runes)))
+		}
+		from, to := clamp(start), clamp(end)
+		if from >= to {
+			return ""
+		}
+		return string(runes[from:to])
+	})
+}
+
+// Len returns a "number" Series with the length of each value in runes; nulls have length 0.
+// Examples:
+//
+//	series.New([]string{"été", "a"}, "string").Str().Len() // [3, 1]
+func (m StringMethods[T]) Len() Series[int] {
+	out := make([]int, len(m.s.data))
+	for i, value := range m.s.data {
+		if v, ok := stringValue(value); ok {
+			out[i] = utf8.RuneCountInString(v)
+		}
+	}
+	return Series[int]{out, "number"}
+}
+
+// transform applies fn to every non-null value.
+func (m StringMethods[T]) transform(fn func(string) string) Series[T] {
+	out := make([]T, len(m.s.data))
+	for i, value := range m.s.data {
+		if v, ok := stringValue(value); ok {
+			out[i] = fromString[T](fn(v))
+		}
+	}
+	return Series[T]{out, m.s.t}
+}
+
+// predicate applies fn to every non-null value; nulls are false.
+func (m StringMethods[T]) predicate(fn func(string) bool) Series[bool] {
+	out := make([]bool, len(m.s.data))
+	for i, value := range m.s.data {
+		if v, ok := stringValue(value); ok {
+			out[i] = fn(v)
+		}
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// stringValue returns the string held by value, or false for a null.
+func stringValue(value any) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.String {
+			return rv.String(), true
+		}
+		return fmt.Sprint(v), true
+	}
+}
+
+// fromString converts a string back to the element type T (string, a named string type or any).
+func fromString[T any](s string) T {
+	if v, ok := any(s).(T); ok {
+		return v
+	}
+	var out T
+	reflect.ValueOf(&out).Elem().SetString(s)
+	return out
+}
@@ -0,0 +1,97 @@
+package series
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_StrTransform(t *testing.T) {
+	names := NewNullable([]any{" jean-PAUL ", nil, "été"}, "string")
+	testCases := []struct {
+		name     string
+		got      Series[any]
+		expected []any
+	}{
+		{"upper", names.Str().Upper(), []any{" JEAN-PAUL ", nil, "ÉTÉ"}},
+		{"lower", names.Str().Lower(), []any{" jean-paul ", nil, "été"}},
+		{"title", names.Str().Title(), []any{" Jean-Paul ", nil, "Été"}},
+		{"trim space", names.Str().TrimSpace(), []any{"jean-PAUL", nil, "été"}},
+		{"trim", names.Str().Trim(" é"), []any{"jean-PAUL", nil, "t"}},
+		{"trim prefix", names.Str().TrimPrefix(" jean"), []any{"-PAUL ", nil, "été"}},
+		{"replace", names.Str().Replace(regexp.MustCompile(`[aeiou]`), "_"), []any{" j__n-PAUL ", nil, "été"}},
+		{"extract", names.Str().Extract(regexp.MustCompile(`(\w+)-(\w+)`), 2), []any{"PAUL", nil, nil}},
+		{"pad", names.Str().Pad(5, "left", '*'), []any{" jean-PAUL ", nil, "**été"}},
+		{"pad both", names.Str().Pad(6, "both", '*'), []any{" jean-PAUL ", nil, "*été**"}},
+		{"slice", names.Str().Slice(1, 5), []any{"jean", nil, "té"}},
+		{"slice negative", names.Str().Slice(-5, -1), []any{"PAUL", nil, "ét"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if !is.SameSlice(testCase.got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_StrPredicate(t *testing.T) {
+	codes := New([]string{"FR-75001", "BE-1000", "fr-13001"}, "string")
+	testCases := []struct {
+		name     string
+		got      Series[bool]
+		expected []bool
+	}{
+		{"contains", codes.Str().Contains("100"), []bool{false, true, false}},
+		{"starts with", codes.Str().StartsWith("FR"), []bool{true, false, false}},
+		{"ends with", codes.Str().EndsWith("01"), []bool{true, false, true}},
+		{"match", codes.Str().Match(regexp.MustCompile(`^[A-Z]{2}-\d{5}$`)), []bool{true, false, false}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if !is.SameSlice(testCase.got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_StrSplitLen(t *testing.T) {
+	s := New([]string{"Ana Maria Smith", "Bob"}, "string")
+	parts := s.Str().Split(" ", 2)
+	if len(parts) != 2 {
+		t.Fatalf("Expected 2 parts, got %d", len(parts))
+	}
+	if !is.SameSlice(parts[0].ToSlice(), []string{"Ana", "Bob"}) || !is.SameSlice(parts[1].ToSlice(), []string{"Maria Smith", ""}) {
+		t.Errorf("Unexpected split %v %v", parts[0].ToSlice(), parts[1].ToSlice())
+	}
+	all := s.Str().Split(" ", -1)
+	if len(all) != 3 || !is.SameSlice(all[1].ToSlice(), []string{"Maria", ""}) || !is.SameSlice(all[2].ToSlice(), []string{"Smith", ""}) {
+		t.Errorf("Unexpected split on every separator %v", all)
+	}
+	if none := s.Str().Split(" ", 0); len(none) != 0 {
+		t.Errorf("Expected no part, got %v", none)
+	}
+	if got := s.Str().Len(); !is.SameSlice(got.ToSlice(), []int{15, 3}) || got.Type() != "number" {
+		t.Errorf("Expected [15 3], got %v (%s)", got.ToSlice(), got.Type())
+	}
+}
+
+func TestSeries_StrPanicsOnNonStringElements(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	Series[int]{[]int{1}, "string"}.Str()
+}
+
+func TestSeries_StrPanicsOnNonString(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	New([]int{1}, "number").Str()
+}
@@ -0,0 +1,165 @@
+package series
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Keep values accepted by Duplicated.
+const (
+	KeepFirst = "first" // every occurrence but the first is a duplicate
+	KeepLast  = "last"  // every occurrence but the last is a duplicate
+	KeepNone  = "none"  // every occurrence of a repeated value is a duplicate
+)
+
+// nullKey is the hash key of null values.
+type nullKey struct{}
+
+// formattedKey is the hash key of values that cannot be map keys, identified by their
+// type and their Go syntax representation. Being a distinct type, it never equals a
+// string value.
+type formattedKey struct {
+	t    reflect.Type
+	text string
+}
+
+// hashKey returns a comparable key identifying v, usable as a map key.
+// Hashable values are their own key; other values (slices, maps, structs or arrays
+// holding them...) are keyed by a formattedKey.
+func hashKey(v any) any {
+	if v == nil {
+		return nullKey{}
+	}
+	if hashable(reflect.ValueOf(v)) {
+		return v
+	}
+	return formattedKey{reflect.TypeOf(v), fmt.Sprintf("%#v", v)}
+}
+
+// hashable reports whether v can be used as a map key without panicking: unlike
+// reflect.Type.Comparable, it looks at the dynamic values of interfaces.
+func hashable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return v.IsNil() || hashable(v.Elem())
+	case reflect.Array:
+		for i := range v.Len() {
+			if !hashable(v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if !hashable(v.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Factorize encodes the Series as integer codes: equal values share the same code and
+// codes are numbered in order of first appearance. It also returns the distinct values,
+// where uniques.GetValue(code) is the value behind a code. Null is a value like any other.
+// Examples:
+//
+//	s := series.New([]string{"b", "a", "b"}, "string")
+//	codes, uniques := s.Factorize() // codes: [0, 1, 0], uniques: ["b", "a"]
+func (s Series[T]) Factorize() (Series[int], Series[T]) {
+	seen := map[any]int{}
+	codes := make([]int, len(s.data))
+	var uniques []T
+	for i, value := range s.data {
+		key := hashKey(value)
+		code, ok := seen[key]
+		if !ok {
+			code = len(uniques)
+			seen[key] = code
+			uniques = append(uniques, value)
+		}
+		codes[i] = code
+	}
+	return Series[int]{codes, "number"}, Series[T]{uniques, s.t}
+}
+
+// Unique returns the distinct values of the Series in order of first appearance.
+// Examples:
+//
+//	s := series.New([]int{3, 1, 3, 2, 1}, "number")
+//	s.Unique() // return Series of type number with values [3, 1, 2]
+func (s Series[T]) Unique() Series[T] {
+	_, uniques := s.Factorize()
+	return uniques
+}
+
+// NUnique returns the number of distinct non-null values of the Series.
+// Examples:
+//
+//	s := series.NewNullable([]any{3, 1, 3, nil}, "number")
+//	s.NUnique() // return 2
+func (s Series[T]) NUnique() int {
+	uniques := s.Unique()
+	return uniques.Len() - uniques.NullCount()
+}
+
+// Duplicated returns a bool Series marking repeated values.
+// keep is KeepFirst (the first occurrence is not marked), KeepLast (the last occurrence
+// is not marked) or KeepNone (every occurrence is marked).
+// Examples:
+//
+//	s := series.New([]int{1, 2, 1, 1}, "number")
+//	s.Duplicated(series.KeepFirst) // [false, false, true, true]
+//	s.Duplicated(series.KeepLast)  // [true, false, true, false]
+//	s.Duplicated(series.KeepNone)  // [true, false, true, true]
+func (s Series[T]) Duplicated(keep string) Series[bool] {
+	codes, uniques := s.Factorize()
+	counts := make([]int, uniques.Len())
+	for _, code := range codes.data {
+		counts[code]++
+	}
+	out := make([]bool, len(s.data))
+	seen := make([]int, uniques.Len())
+	for i, code := range codes.data {
+		seen[code]++
+		switch keep {
+		case KeepLast:
+			out[i] = seen[code] < counts[code]
+		case KeepNone:
+			out[i] = counts[code] > 1
+		default:
+			out[i] = seen[code] > 1
+		}
+	}
+	return Series[bool]{out, "bool"}
+}
+
+// ValueCounts returns the distinct non-null values of the Series and how many times each
+// appears, sorted by decreasing count (ties keep the order of first appearance).
+// Examples:
+//
+//	s := series.New([]string{"a", "b", "b"}, "string")
+//	values, counts := s.ValueCounts() // values: ["b", "a"], counts: [2, 1]
+func (s Series[T]) ValueCounts() (Series[T], Series[int]) {
+	codes, uniques := s.Factorize()
+	counts := make([]int, uniques.Len())
+	for _, code := range codes.data {
+		counts[code]++
+	}
+	order := make([]int, 0, uniques.Len())
+	for code, value := range uniques.data {
+		if !isNull(value) {
+			order = append(order, code)
+		}
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return counts[b] - counts[a] })
+	values := make([]T, len(order))
+	sorted := make([]int, len(order))
+	for i, code := range order {
+		values[i] = uniques.data[code]
+		sorted[i] = counts[code]
+	}
+	return Series[T]{values, s.t}, Series[int]{sorted, "number"}
+}
@@ -0,0 +1,94 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Unique(t *testing.T) {
+	testCases := []struct {
+		name            string
+		value           []any
+		expectedUnique  []any
+		expectedNUnique int
+		expectedCodes   []int
+	}{
+		{"numbers with null", []any{3, 1, nil, 3, 2, 1, nil}, []any{3, 1, nil, 2}, 3, []int{0, 1, 2, 0, 3, 1, 2}},
+		{"empty", []any{}, nil, 0, []int{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			s := NewNullable(testCase.value, "number")
+			if got := s.Unique(); !is.SameSlice(got.ToSlice(), testCase.expectedUnique) {
+				tt.Errorf("Expected %v, got %v", testCase.expectedUnique, got.ToSlice())
+			}
+			if got := s.NUnique(); got != testCase.expectedNUnique {
+				tt.Errorf("Expected %v, got %v", testCase.expectedNUnique, got)
+			}
+			if codes, _ := s.Factorize(); !is.SameSlice(codes.ToSlice(), testCase.expectedCodes) {
+				tt.Errorf("Expected %v, got %v", testCase.expectedCodes, codes.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_UniqueNotComparable(t *testing.T) {
+	s := New([]any{[]int{1}, []int{1}, []int{2}}, "string")
+	if got := s.NUnique(); got != 2 {
+		t.Errorf("Expected 2, got %v", got)
+	}
+}
+
+func TestSeries_UniqueHashKeys(t *testing.T) {
+	type holder struct{ v any }
+	testCases := []struct {
+		name     string
+		value    []any
+		expected int
+	}{
+		// a string spelling the formatted key of a slice is another value
+		{"string like a formatted slice", []any{[]int{1}, "[]int:[]int{1}", "[]int{1}"}, 3},
+		{"struct holding a slice", []any{holder{[]int{1}}, holder{[]int{1}}, holder{[]int{2}}}, 2},
+		{"struct holding a number", []any{holder{1}, holder{1}, holder{"1"}}, 2},
+		{"array holding a map", []any{[1]any{map[string]int{"a": 1}}, [1]any{map[string]int{"a": 1}}}, 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if got := New(testCase.value, "string").NUnique(); got != testCase.expected {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSeries_Duplicated(t *testing.T) {
+	testCases := []struct {
+		name     string
+		keep     string
+		expected []bool
+	}{
+		{"keep first", KeepFirst, []bool{false, false, true, true}},
+		{"keep last", KeepLast, []bool{true, false, true, false}},
+		{"keep none", KeepNone, []bool{true, false, true, true}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			got := New([]int{1, 2, 1, 1}, "number").Duplicated(testCase.keep)
+			if !is.SameSlice(got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_ValueCounts(t *testing.T) {
+	s := NewNullable([]any{"a", "b", nil, "c", "b", "c", "c"}, "string")
+	values, counts := s.ValueCounts()
+	if !is.SameSlice(values.ToSlice(), []any{"c", "b", "a"}) {
+		t.Errorf("Expected [c b a], got %v", values.ToSlice())
+	}
+	if !is.SameSlice(counts.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", counts.ToSlice())
+	}
+}
@@ -0,0 +1,217 @@
+package series
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// operand is implemented by every Series whatever its element type, so that
+// element-wise operations accept a Series[U] as right-hand side of a Series[T].
+type operand interface {
+	Type() string
+	Len() int
+	valueAt(index int) any
+}
+
+// valueAt returns the value at index as an interface value.
+func (s Series[T]) valueAt(index int) any {
+	return s.data[index]
+}
+
+// broadcast turns the right-hand side of an element-wise operation into an accessor
+// over n values and its type tag. A Series is used as is (its length must be n), any
+// other value is a scalar repeated n times. The tag of a nil scalar is "null".
+func broadcast(other any, n int) (func(i int) any, string) {
+	if o, ok := other.(operand); ok {
+		if o.Len() != n {
+			panic(fmt.Sprintf("length mismatch: %d and %d", n, o.Len()))
+		}
+		return o.valueAt, o.Type()
+	}
+	return func(int) any { return other }, scalarType(other)
+}
+
+// scalarType returns the type tag matching a Go value, "null" for nil.
+func scalarType(v any) string {
+	if v == nil {
+		return "null"
+	}
+	if _, ok := v.(time.Time); ok {
+		return "date"
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "number"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Bool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// IsNumericType reports whether the type tag t is "number" or "float".
+// Examples:
+//
+//	series.IsNumericType("float")  // true
+//	series.IsNumericType("string") // false
+func IsNumericType(t string) bool {
+	return t == "number" || t == "float"
+}
+
+// ToFloat converts any integer or floating point value to float64.
+// It returns false when v is not numeric (nil included).
+// Examples:
+//
+//	series.ToFloat(int32(3)) // 3, true
+//	series.ToFloat("3")      // 0, false
+func ToFloat(v any) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// ToInt converts an integer value, or a float without fractional part, to int.
+// It returns false for any other value (nil included) and for values out of the int range
+// (NaN and infinities included).
+// Examples:
+//
+//	series.ToInt(uint8(3)) // 3, true
+//	series.ToInt(3.0)      // 3, true
+//	series.ToInt(3.5)      // 0, false
+//	series.ToInt(1e30)     // 0, false
+func ToInt(v any) (int, bool) {
+	if v == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		return int(u), u <= math.MaxInt
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		// -2^63 is exact as a float64, 2^63 is the first value out of range
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= -math.MinInt64 {
+			return 0, false
+		}
+		return int(f), true
+	default:
+		return 0, false
+	}
+}
+
+// CompareType returns the type used to compare values tagged a and b, or false if
+// they cannot be compared. Numbers compare with floats, dates with strings, and
+// nulls with anything.
+// Examples:
+//
+//	series.CompareType("number", "float") // "float", true
+//	series.CompareType("string", "date")  // "date", true
+//	series.CompareType("bool", "number")  // "", false
+func CompareType(a string, b string) (string, bool) {
+	switch {
+	case b == "null":
+		return a, true
+	case a == "null":
+		return b, true
+	case a == b:
+		return a, true
+	case IsNumericType(a) && IsNumericType(b):
+		return "float", true
+	case a == "date" && b == "string", a == "string" && b == "date":
+		return "date", true
+	}
+	return "", false
+}
+
+// intPair returns a and b as ints when both are Go integers (not floats) within the int
+// range.
+func intPair(a any, b any) (int, int, bool) {
+	if !isIntKind(a) || !isIntKind(b) {
+		return 0, 0, false
+	}
+	ia, okA := ToInt(a)
+	ib, okB := ToInt(b)
+	return ia, ib, okA && okB
+}
+
+// isIntKind reports whether v holds a signed or unsigned Go integer.
+func isIntKind(v any) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// CompareValues compares two non-null values as values of type t and returns -1, 0 or +1.
+// Two integers are compared exactly; numbers mixing integers and floats are compared as
+// float64. It returns false when the values cannot be compared (e.g. an unparsable date).
+// Examples:
+//
+//	series.CompareValues(1, 2.5, "float")           // -1, true
+//	series.CompareValues("2024-03-01", day, "date") // 0, true (day is 2024-03-01)
+//	series.CompareValues(true, false, "bool")       // 1, true
+func CompareValues(a any, b any, t string) (int, bool) {
+	switch t {
+	case "number", "float":
+		if ia, ib, ok := intPair(a, b); ok {
+			return cmp.Compare(ia, ib), true
+		}
+		fa, okA := ToFloat(a)
+		fb, okB := ToFloat(b)
+		if !okA || !okB {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	case "date":
+		ta, errA := ParseDate(a)
+		tb, errB := ParseDate(b)
+		if errA != nil || errB != nil {
+			return 0, false
+		}
+		return ta.Compare(tb), true
+	case "bool":
+		ba, okA := a.(bool)
+		bb, okB := b.(bool)
+		if !okA || !okB {
+			return 0, false
+		}
+		if ba == bb {
+			return 0, true
+		}
+		if !ba {
+			return -1, true
+		}
+		return 1, true
+	default:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b)), true
+	}
+}
@@ -0,0 +1,251 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Window computes statistics over a moving (see Series.Rolling) or growing
+// (see Series.Expanding) window of a numeric Series. Every method returns a "float"
+// Series of the same length; positions whose window holds fewer than minPeriods non-null
+// values are null. Nulls inside a window are skipped.
+type Window[T any] struct {
+	s          Series[T]
+	size       int // 0 for an expanding window
+	minPeriods int
+}
+
+// Rolling returns a moving window of the given size ending at each position.
+// minPeriods is the minimum number of non-null values required to produce a result;
+// values below 1 mean the full window size. It panics if the Series is not numeric or
+// size is below 1.
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3, 4}, "number")
+//	s.Rolling(2, 0).Sum()  // [<nil>, 3, 5, 7]
+//	s.Rolling(3, 1).Mean() // [1, 1.5, 2, 3]
+func (s Series[T]) Rolling(size int, minPeriods int) Window[T] {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid window size %d", size))
+	}
+	if minPeriods < 1 {
+		minPeriods = size
+	}
+	return newWindow(s, size, minPeriods)
+}
+
+// Expanding returns a window growing from the first value to each position.
+// minPeriods is the minimum number of non-null values required to produce a result;
+// values below 1 mean 1. It panics if the Series is not numeric.
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3, 4}, "number")
+//	s.Expanding(1).Max() // [1, 2, 3, 4]
+//	s.Expanding(2).Sum() // [<nil>, 3, 6, 10]
+func (s Series[T]) Expanding(minPeriods int) Window[T] {
+	return newWindow(s, 0, max(minPeriods, 1))
+}
+
+func newWindow[T any](s Series[T], size int, minPeriods int) Window[T] {
+	if !IsNumericType(s.t) {
+		panic(fmt.Sprintf("window not defined on %s", s.t))
+	}
+	return Window[T]{s, size, minPeriods}
+}
+
+// Sum returns the sum of each window.
+func (w Window[T]) Sum() Series[any] {
+	return w.running(func(total float64, count int) float64 { return total })
+}
+
+// Mean returns the arithmetic mean of each window.
+func (w Window[T]) Mean() Series[any] {
+	return w.running(func(total float64, count int) float64 { return total / float64(count) })
+}
+
+// Count returns the number of non-null values of each window.
+func (w Window[T]) Count() Series[any] {
+	return w.running(func(total float64, count int) float64 { return float64(count) })
+}
+
+// Min returns the smallest value of each window.
+func (w Window[T]) Min() Series[any] {
+	return w.Apply(func(values []float64) float64 { return slices.Min(values) })
+}
+
+// Max returns the largest value of each window.
+func (w Window[T]) Max() Series[any] {
+	return w.Apply(func(values []float64) float64 { return slices.Max(values) })
+}
+
+// Std returns the sample standard deviation of each window; a window with a single
+// value gives null.
+func (w Window[T]) Std() Series[any] {
+	out := w.Apply(func(values []float64) float64 {
+		if len(values) < 2 {
+			return math.NaN()
+		}
+		m := mean(values)
+		sq := 0.0
+		for _, v := range values {
+			sq += (v - m) * (v - m)
+		}
+		return math.Sqrt(sq / float64(len(values)-1))
+	})
+	for i, v := range out.data {
+		if f, ok := v.(float64); ok && math.IsNaN(f) {
+			out.data[i] = nil
+		}
+	}
+	return out
+}
+
+// Apply returns the result of fn over the non-null values of each window. fn must not
+// modify or keep values, which may be reused by the next windows.
+// Examples:
+//
+//	s := series.New([]float64{1, 5, 2}, "float")
+//	s.Rolling(2, 0).Apply(func(v []float64) float64 { return v[len(v)-1] - v[0] }) // [<nil>, 4, -3]
+func (w Window[T]) Apply(fn func(values []float64) float64) Series[any] {
+	out := make([]any, len(w.s.data))
+	var values []float64
+	for i := range w.s.data {
+		if w.size > 0 {
+			// a moving window is collected again, an expanding one only grows
+			values = values[:0]
+			for _, v := range w.s.data[max(0, i-w.size+1) : i+1] {
+				if f, ok := ToFloat(v); ok {
+					values = append(values, f)
+				}
+			}
+		} else if f, ok := ToFloat(w.s.data[i]); ok {
+			values = append(values, f)
+		}
+		if len(values) >= w.minPeriods {
+			out[i] = fn(values)
+		}
+	}
+	return Series[any]{out, "float"}
+}
+
+// running returns result(total, count) for each window, over the total and the count of
+// its non-null values. A moving window is summed again at each position, since removing
+// the values leaving it would lose precision and keep NaN and infinities forever; an
+// expanding window only grows, so its total is carried over.
+func (w Window[T]) running(result func(total float64, count int) float64) Series[any] {
+	out := make([]any, len(w.s.data))
+	total, count := 0.0, 0
+	for i, v := range w.s.data {
+		if w.size > 0 {
+			total, count = 0, 0
+			for _, v := range w.s.data[max(0, i-w.size+1) : i+1] {
+				if f, ok := ToFloat(v); ok {
+					total += f
+					count++
+				}
+			}
+		} else if f, ok := ToFloat(v); ok {
+			total += f
+			count++
+		}
+		if count >= w.minPeriods {
+			out[i] = result(total, count)
+		}
+	}
+	return Series[any]{out, "float"}
+}
+
+// EWMWindow computes exponentially weighted statistics (see Series.EWM).
+type EWMWindow[T any] struct {
+	s     Series[T]
+	alpha float64
+}
+
+// SpanAlpha converts a span (the "N-day" of an N-day moving average) into the
+// smoothing factor expected by EWM: 2 / (span + 1).
+func SpanAlpha(span float64) float64 {
+	return 2 / (span + 1)
+}
+
+// EWM returns an exponentially weighted window with smoothing factor alpha in (0, 1]
+// (use SpanAlpha to derive it from a span). It panics if the Series is not numeric or
+// alpha is out of range.
+// Examples:
+//
+//	s := series.New([]float64{1, 2, 3}, "float")
+//	s.EWM(0.5).Mean() // [1, 1.5, 2.25]
+func (s Series[T]) EWM(alpha float64) EWMWindow[T] {
+	if !IsNumericType(s.t) {
+		panic(fmt.Sprintf("window not defined on %s", s.t))
+	}
+	if alpha <= 0 || alpha > 1 {
+		panic(fmt.Sprintf("invalid alpha %v", alpha))
+	}
+	return EWMWindow[T]{s, alpha}
+}
+
+// Mean returns the exponentially weighted moving average: each result is
+// alpha*value + (1-alpha)*previous. Nulls keep the previous average; positions before
+// the first non-null value are null.
+func (w EWMWindow[T]) Mean() Series[any] {
+	out := make([]any, len(w.s.data))
+	var last any
+	for i, v := range w.s.data {
+		if f, ok := ToFloat(v); ok {
+			if prev, started := last.(float64); started {
+				f = w.alpha*f + (1-w.alpha)*prev
+			}
+			last = f
+		}
+		out[i] = last
+	}
+	return Series[any]{out, "float"}
+}
+
+// Lag returns a Series of the same type where values are moved n positions forward
+// (backward when n is negative); vacated positions are null.
+// Examples:
+//
+//	s := series.New([]int{1, 2, 3}, "number")
+//	s.Lag(1)  // [<nil>, 1, 2]
+//	s.Lag(-1) // [2, 3, <nil>]
+func (s Series[T]) Lag(n int) Series[any] {
+	out := make([]any, len(s.data))
+	for i := range out {
+		if j := i - n; j >= 0 && j < len(s.data) {
+			out[i] = s.data[j]
+		}
+	}
+	return Series[any]{out, s.t}
+}
+
+// Diff returns the difference between each value and the value n positions before
+// (after when n is negative). The first n positions are null.
+// Examples:
+//
+//	s := series.New([]int{1, 4, 9}, "number")
+//	s.Diff(1) // [<nil>, 3, 5]
+func (s Series[T]) Diff(n int) Series[any] {
+	return s.Sub(s.Lag(n))
+}
+
+// PctChange returns the relative change between each value and the value n positions
+// before, as a "float" Series. The first n positions are null.
+// Examples:
+//
+//	s := series.New([]float64{100, 110, 99}, "float")
+//	s.PctChange(1) // [<nil>, 0.1, -0.1]
+func (s Series[T]) PctChange(n int) Series[any] {
+	previous := s.Lag(n)
+	return s.Sub(previous).Div(previous)
+}
+
+// mean returns the arithmetic mean of a non-empty slice.
+func mean(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
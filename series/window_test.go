@@ -0,0 +1,66 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/visual-pivert/go-starter/is"
+)
+
+func TestSeries_Window(t *testing.T) {
+	numbers := NewNullable([]any{1, 2, nil, 4, 5}, "number")
+	testCases := []struct {
+		name     string
+		got      Series[any]
+		expected []any
+	}{
+		{"rolling sum", numbers.Rolling(2, 0).Sum(), []any{nil, 3.0, nil, nil, 9.0}},
+		{"rolling sum min periods", numbers.Rolling(2, 1).Sum(), []any{1.0, 3.0, 2.0, 4.0, 9.0}},
+		{"rolling mean", numbers.Rolling(3, 2).Mean(), []any{nil, 1.5, 1.5, 3.0, 4.5}},
+		{"rolling min", numbers.Rolling(3, 1).Min(), []any{1.0, 1.0, 1.0, 2.0, 4.0}},
+		{"rolling max", numbers.Rolling(3, 1).Max(), []any{1.0, 2.0, 2.0, 4.0, 5.0}},
+		{"rolling std", New([]int{1, 2, 3, 5}, "number").Rolling(3, 1).Std(), []any{nil, 0.7071067811865476, 1.0, 1.5275252316519465}},
+		{"rolling apply", numbers.Rolling(2, 2).Apply(func(v []float64) float64 { return v[1] - v[0] }), []any{nil, 1.0, nil, nil, 1.0}},
+		{"expanding sum", numbers.Expanding(1).Sum(), []any{1.0, 3.0, 3.0, 7.0, 12.0}},
+		{"rolling count", numbers.Rolling(2, 1).Count(), []any{1.0, 2.0, 1.0, 1.0, 2.0}},
+		{"rolling sum of large values", New([]float64{1e17, 1, 2, 3}, "float").Rolling(2, 0).Sum(), []any{nil, 1e17, 3.0, 5.0}},
+		{"rolling sum after a NaN", New([]float64{1, math.NaN(), 2, 3, 4}, "float").Rolling(2, 1).Sum(), []any{1.0, math.NaN(), math.NaN(), 5.0, 7.0}},
+		{"rolling mean after an infinity", New([]float64{math.Inf(1), 1, 2, 3}, "float").Rolling(2, 0).Mean(), []any{nil, math.Inf(1), 1.5, 2.5}},
+		{"expanding mean", numbers.Expanding(2).Mean(), []any{nil, 1.5, 1.5, 7.0 / 3, 3.0}},
+		{"expanding count", numbers.Expanding(1).Count(), []any{1.0, 2.0, 2.0, 3.0, 4.0}},
+		{"expanding apply", numbers.Expanding(1).Apply(func(v []float64) float64 { return v[len(v)-1] - v[0] }), []any{0.0, 1.0, 1.0, 3.0, 4.0}},
+		{"expanding min periods", numbers.Expanding(3).Max(), []any{nil, nil, nil, 4.0, 5.0}},
+		{"ewm mean", NewNullable([]any{nil, 1.0, 2.0, nil, 3.0}, "float").EWM(0.5).Mean(), []any{nil, 1.0, 1.5, 1.5, 2.25}},
+		{"lag", numbers.Lag(2), []any{nil, nil, 1, 2, nil}},
+		{"lead", numbers.Lag(-1), []any{2, nil, 4, 5, nil}},
+		{"diff", numbers.Diff(1), []any{nil, 1, nil, nil, 1}},
+		{"pct change", New([]float64{100, 110, 99}, "float").PctChange(1), []any{nil, 0.1, -0.1}},
+		{"empty", New([]int{}, "number").Rolling(2, 0).Sum(), []any{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			if len(testCase.got.ToSlice()) != len(testCase.expected) {
+				tt.Fatalf("Expected length %d, got %d", len(testCase.expected), len(testCase.got.ToSlice()))
+			}
+			if !is.Equal(testCase.got.ToSlice(), testCase.expected) {
+				tt.Errorf("Expected %v, got %v", testCase.expected, testCase.got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSeries_WindowTypes(t *testing.T) {
+	numbers := New([]int{1, 2}, "number")
+	if got := numbers.Rolling(1, 0).Sum().Type(); got != "float" {
+		t.Errorf("Expected float, got %v", got)
+	}
+	if got := numbers.Diff(1).Type(); got != "number" {
+		t.Errorf("Expected number, got %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic on a string Series")
+		}
+	}()
+	New([]string{"a"}, "string").Rolling(1, 0)
+}